@@ -0,0 +1,12 @@
+data "ceph_cephfs" "shared" {
+  name = "shared"
+}
+
+# Values for the ceph-csi CephFS StorageClass
+output "fs_name" {
+  value = data.ceph_cephfs.shared.name
+}
+
+output "data_pool" {
+  value = data.ceph_cephfs.shared.data_pools[0]
+}
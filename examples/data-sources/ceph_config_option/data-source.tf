@@ -0,0 +1,11 @@
+data "ceph_config_option" "rbd_default_features" {
+  name = "rbd_default_features"
+}
+
+output "rbd_default_features" {
+  value = {
+    type    = data.ceph_config_option.rbd_default_features.type
+    default = data.ceph_config_option.rbd_default_features.default
+    runtime = data.ceph_config_option.rbd_default_features.can_update_at_runtime
+  }
+}
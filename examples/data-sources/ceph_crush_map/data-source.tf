@@ -0,0 +1,20 @@
+data "ceph_crush_map" "main" {}
+
+locals {
+  racks = [for b in data.ceph_crush_map.main.buckets : b.name if b.type == "rack"]
+}
+
+# Spread replicas across racks only if the cluster has enough of them
+resource "ceph_crush_rule" "rack_spread" {
+  name           = "rack_spread_rule"
+  root           = data.ceph_crush_map.main.roots[0]
+  failure_domain = length(local.racks) >= 3 ? "rack" : "host"
+  device_class   = "ssd"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.ceph_crush_map.main.device_classes, "ssd")
+      error_message = "The cluster has no SSD OSDs."
+    }
+  }
+}
@@ -5,3 +5,11 @@ data "ceph_crush_rule" "default" {
 output "rule_id" {
   value = data.ceph_crush_rule.default.rule_id
 }
+
+output "failure_domain" {
+  value = data.ceph_crush_rule.default.failure_domain
+}
+
+output "steps" {
+  value = data.ceph_crush_rule.default.steps
+}
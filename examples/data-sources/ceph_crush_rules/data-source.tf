@@ -0,0 +1,10 @@
+data "ceph_crush_rules" "all" {}
+
+output "rule_names" {
+  value = [for r in data.ceph_crush_rules.all.rules : r.name]
+}
+
+# Rules restricted to SSD devices
+output "ssd_rules" {
+  value = [for r in data.ceph_crush_rules.all.rules : r.name if r.device_class == "ssd"]
+}
@@ -0,0 +1,21 @@
+data "ceph_df" "current" {}
+
+locals {
+  ssd = one([for c in data.ceph_df.current.classes : c if c.device_class == "ssd"])
+}
+
+output "capacity" {
+  value = {
+    raw_used = format("%.1f%%", data.ceph_df.current.used_ratio * 100)
+    ssd_free = local.ssd.avail_bytes
+    pools    = { for p in data.ceph_df.current.pools : p.name => p.stored_bytes }
+  }
+}
+
+# Pools above 80% of their byte quota
+output "pools_near_quota" {
+  value = [
+    for p in data.ceph_df.current.pools : p.name
+    if p.quota_bytes_ratio != null && p.quota_bytes_ratio > 0.8
+  ]
+}
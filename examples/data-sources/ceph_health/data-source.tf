@@ -0,0 +1,29 @@
+data "ceph_health" "current" {}
+
+output "health" {
+  value = {
+    status   = data.ceph_health.current.status
+    checks   = [for c in data.ceph_health.current.checks : "${c.type}: ${c.summary}"]
+    osds_up  = "${data.ceph_health.current.osds_up}/${data.ceph_health.current.osds_total}"
+    capacity = format("%.1f%% used", data.ceph_health.current.used_ratio * 100)
+  }
+}
+
+# Stop at plan time when the cluster is unhealthy or nearly full
+resource "ceph_pool" "analytics" {
+  name   = "analytics"
+  pg_num = 64
+  type   = "replicated"
+  size   = 3
+
+  lifecycle {
+    precondition {
+      condition     = data.ceph_health.current.status == "HEALTH_OK"
+      error_message = "The cluster is ${data.ceph_health.current.status}; fix its health before changing pools."
+    }
+    precondition {
+      condition     = data.ceph_health.current.used_ratio < 0.75
+      error_message = "The cluster is more than 75% full."
+    }
+  }
+}
@@ -0,0 +1,14 @@
+data "ceph_hosts" "rgw" {
+  label = "rgw"
+}
+
+output "rgw_addresses" {
+  value = [for h in data.ceph_hosts.rgw.hosts : h.addr]
+}
+
+# Devices available for new OSDs
+output "available_devices" {
+  value = flatten([
+    for h in data.ceph_hosts.rgw.hosts : [for d in h.devices : "${h.hostname}:${d.path}" if d.available]
+  ])
+}
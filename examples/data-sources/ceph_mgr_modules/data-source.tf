@@ -0,0 +1,10 @@
+data "ceph_mgr_modules" "all" {}
+
+output "enabled_modules" {
+  value = data.ceph_mgr_modules.all.enabled
+}
+
+# Modules that can be enabled
+output "available_modules" {
+  value = [for m in data.ceph_mgr_modules.all.modules : m.name if !m.enabled]
+}
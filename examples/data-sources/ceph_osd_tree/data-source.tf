@@ -0,0 +1,9 @@
+data "ceph_osd_tree" "main" {}
+
+output "down_osds" {
+  value = [for n in data.ceph_osd_tree.main.nodes : n.name if n.type == "osd" && n.status != "up"]
+}
+
+output "hosts" {
+  value = { for n in data.ceph_osd_tree.main.nodes : n.name => n.children if n.type == "host" }
+}
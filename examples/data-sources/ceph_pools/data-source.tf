@@ -0,0 +1,22 @@
+# All RBD pools named like "volumes-*"
+data "ceph_pools" "volumes" {
+  application = "rbd"
+  name_regex  = "^volumes-"
+}
+
+# One RBD user per existing pool, without hardcoding the pool names
+resource "ceph_user" "volumes" {
+  for_each = toset(data.ceph_pools.volumes.names)
+
+  name  = "client.${each.key}"
+  pools = [each.key]
+}
+
+# Audit: erasure-coded pools without a byte quota
+data "ceph_pools" "erasure" {
+  type = "erasure"
+}
+
+output "erasure_pools_without_quota" {
+  value = [for p in data.ceph_pools.erasure.pools : p.name if p.quota_max_bytes == 0]
+}
@@ -0,0 +1,5 @@
+data "ceph_rbd_mirroring" "status" {}
+
+output "mirroring_unhealthy_pools" {
+  value = [for p in data.ceph_rbd_mirroring.status.pools : p.name if p.mode != "disabled" && p.health != "OK"]
+}
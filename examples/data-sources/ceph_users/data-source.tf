@@ -0,0 +1,16 @@
+data "ceph_users" "csi" {
+  entity_prefix = "client.csi-"
+}
+
+output "csi_users" {
+  value = { for u in data.ceph_users.csi.users : u.name => u.caps }
+}
+
+# Audit: client users with an unrestricted OSD capability
+data "ceph_users" "clients" {
+  entity_prefix = "client."
+}
+
+output "unrestricted_clients" {
+  value = [for u in data.ceph_users.clients.users : u.name if lookup(u.caps, "osd", "") == "allow *"]
+}
@@ -38,4 +38,15 @@ provider "ceph" {
   username = var.ceph_username
   password = var.ceph_password
   insecure = true
-}
\ No newline at end of file
+}
+# Refuse changes to an unhealthy cluster, except during maintenance windows
+# where noout and similar flags are expected
+provider "ceph" {
+  alias    = "guarded"
+  url      = var.ceph_url
+  username = var.ceph_username
+  password = var.ceph_password
+
+  require_healthy       = true
+  allowed_health_checks = ["OSDMAP_FLAGS"]
+}
@@ -0,0 +1,2 @@
+# CephFS clients can be imported by user entity name
+terraform import ceph_cephfs_client.reports client.reports
@@ -0,0 +1,22 @@
+# Client for ceph-csi CephFS static volumes
+resource "ceph_cephfs_client" "reports" {
+  name    = "client.reports"
+  fs_name = "shared"
+
+  paths = [
+    {
+      path       = "/volumes/legacy/reports"
+      permission = "rw"
+    },
+    {
+      path        = "/archive"
+      permission  = "r"
+      root_squash = true
+    },
+  ]
+}
+
+output "reports_key" {
+  value     = ceph_cephfs_client.reports.key
+  sensitive = true
+}
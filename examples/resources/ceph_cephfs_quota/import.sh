@@ -0,0 +1,2 @@
+# Directory quotas can be imported using <fs_name>:<path>
+terraform import ceph_cephfs_quota.projects shared:/shares/projects
@@ -0,0 +1,7 @@
+# Limit a share to 500 GiB and one million files
+resource "ceph_cephfs_quota" "projects" {
+  fs_name   = "shared"
+  path      = "/shares/projects"
+  max_bytes = 536870912000
+  max_files = 1000000
+}
@@ -0,0 +1,2 @@
+# Snapshot schedules can be imported using <fs_name>:<path>:<schedule>
+terraform import ceph_cephfs_snapshot_schedule.projects shared:/shares/projects:1h
@@ -0,0 +1,11 @@
+# Hourly snapshots of a share, keeping a day of hourlies and a week of dailies
+resource "ceph_cephfs_snapshot_schedule" "projects" {
+  fs_name  = "shared"
+  path     = "/shares/projects"
+  schedule = "1h"
+
+  retention = {
+    h = 24
+    d = 7
+  }
+}
@@ -0,0 +1,2 @@
+# Subvolumes can be imported using <volume>:<group>:<subvolume>, or <volume>:<subvolume> for the default group
+terraform import ceph_cephfs_subvolume.reports shared:legacy:reports
@@ -0,0 +1,16 @@
+# Static subvolume for a legacy application
+resource "ceph_cephfs_subvolume" "reports" {
+  volume             = "shared"
+  group              = "legacy"
+  name               = "reports"
+  size               = 107374182400 # 100 GiB, can be resized in place
+  mode               = "770"
+  uid                = 1000
+  gid                = 1000
+  namespace_isolated = true
+}
+
+# Use the path in a static ceph-csi PersistentVolume (volumeAttributes.rootPath)
+output "reports_root_path" {
+  value = ceph_cephfs_subvolume.reports.path
+}
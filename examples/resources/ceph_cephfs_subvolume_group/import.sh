@@ -0,0 +1,2 @@
+# Subvolume groups can be imported using <volume>:<group>
+terraform import ceph_cephfs_subvolume_group.csi shared:csi
@@ -0,0 +1,16 @@
+# Subvolume group used by ceph-csi for dynamic provisioning
+resource "ceph_cephfs_subvolume_group" "csi" {
+  volume = "shared"
+  name   = "csi"
+}
+
+# Group with a quota and a dedicated data pool
+resource "ceph_cephfs_subvolume_group" "legacy" {
+  volume      = "shared"
+  name        = "legacy"
+  size        = 1099511627776 # 1 TiB
+  mode        = "750"
+  uid         = 1000
+  gid         = 1000
+  pool_layout = "cephfs.shared.data-hdd"
+}
@@ -0,0 +1,2 @@
+# CephFS volumes can be imported by file system name
+terraform import ceph_cephfs_volume.shared shared
@@ -0,0 +1,34 @@
+# CephFS volume with pools created automatically
+resource "ceph_cephfs_volume" "shared" {
+  name                 = "shared"
+  max_mds              = 2
+  standby_count_wanted = 1
+  allow_standby_replay = true
+
+  placement = {
+    count = 3
+    label = "mds"
+  }
+
+  # Set to true and apply before running terraform destroy
+  confirm_destroy = false
+}
+
+# CephFS volume using existing pools
+resource "ceph_pool" "cephfs_metadata" {
+  name                 = "legacy.meta"
+  pg_num               = 16
+  application_metadata = ["cephfs"]
+}
+
+resource "ceph_pool" "cephfs_data" {
+  name                 = "legacy.data"
+  pg_num               = 64
+  application_metadata = ["cephfs"]
+}
+
+resource "ceph_cephfs_volume" "legacy" {
+  name          = "legacy"
+  metadata_pool = ceph_pool.cephfs_metadata.name
+  data_pool     = ceph_pool.cephfs_data.name
+}
@@ -0,0 +1,3 @@
+# Config options can be imported using <section>:<name> or <section>/<mask>:<name>
+terraform import ceph_config.osd_memory_target osd:osd_memory_target
+terraform import ceph_config.osd_memory_target_ssd osd/class:ssd:osd_memory_target
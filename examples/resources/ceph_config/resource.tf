@@ -0,0 +1,19 @@
+resource "ceph_config" "osd_memory_target" {
+  section = "osd"
+  name    = "osd_memory_target"
+  value   = "4G"
+}
+
+# More memory for the OSDs on SSDs
+resource "ceph_config" "osd_memory_target_ssd" {
+  section = "osd"
+  mask    = "class:ssd"
+  name    = "osd_memory_target"
+  value   = "8G"
+}
+
+resource "ceph_config" "mon_allow_pool_delete" {
+  section = "mon"
+  name    = "mon_allow_pool_delete"
+  value   = "false"
+}
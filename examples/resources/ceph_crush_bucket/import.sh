@@ -0,0 +1,2 @@
+# Host buckets can be imported by host name once their crush_location is set
+terraform import ceph_crush_bucket.node1 node1
@@ -0,0 +1,17 @@
+# Place new hosts under their racks before their OSDs are deployed.
+# The racks are created by Ceph when the first OSD of a host starts.
+resource "ceph_crush_bucket" "node1" {
+  name = "node1"
+  location = {
+    rack = "rack1"
+    root = "default"
+  }
+}
+
+resource "ceph_crush_bucket" "node2" {
+  name = "node2"
+  location = {
+    rack = "rack2"
+    root = "default"
+  }
+}
@@ -0,0 +1,2 @@
+# CRUSH rules can be imported by name
+terraform import ceph_crush_rule.ssd_hosts ssd_hosts_rule
@@ -28,3 +28,14 @@ resource "ceph_pool" "fast_pool" {
   type      = "replicated"
   rule_name = ceph_crush_rule.ssd_hosts.name
 }
+
+# Erasure-coded rules are created by Ceph from the erasure code profile of
+# an erasure pool. Once the pool exists, the rule can be imported:
+#   terraform import ceph_crush_rule.ec_hosts ec_hosts_rule
+resource "ceph_pool" "ec_pool" {
+  name                 = "ec-storage"
+  pg_num               = 64
+  type                 = "erasure"
+  erasure_code_profile = "default"
+  rule_name            = "ec_hosts_rule"
+}
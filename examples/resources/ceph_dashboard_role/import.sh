@@ -0,0 +1,2 @@
+# Dashboard roles can be imported using the role name
+terraform import ceph_dashboard_role.operator operator
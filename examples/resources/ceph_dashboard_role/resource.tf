@@ -0,0 +1,14 @@
+# Operators may manage pools and images, and only look at the rest
+resource "ceph_dashboard_role" "operator" {
+  name        = "operator"
+  description = "Storage operators"
+
+  scopes_permissions = {
+    pool        = ["read", "create", "update"]
+    "rbd-image" = ["read", "create", "update", "delete"]
+    hosts       = ["read"]
+    osd         = ["read"]
+    monitor     = ["read"]
+    log         = ["read"]
+  }
+}
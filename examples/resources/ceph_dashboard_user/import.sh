@@ -0,0 +1,3 @@
+# Dashboard users can be imported using the username. The password cannot be
+# read back and is set again on the next apply.
+terraform import ceph_dashboard_user.alice alice
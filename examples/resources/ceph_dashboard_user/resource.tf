@@ -0,0 +1,16 @@
+variable "alice_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "ceph_dashboard_user" "alice" {
+  username = "alice"
+  password = var.alice_password
+  name     = "Alice Martin"
+  email    = "alice@example.com"
+  roles    = [ceph_dashboard_role.operator.name, "read-only"]
+
+  # Alice chooses her own password at the first login
+  pwd_update_required = true
+  pwd_expiration_date = "2027-06-30T00:00:00Z"
+}
@@ -0,0 +1,2 @@
+# Hosts can be imported using the hostname
+terraform import ceph_host.ceph_04 ceph-04
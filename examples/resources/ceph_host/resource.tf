@@ -0,0 +1,13 @@
+resource "ceph_host" "ceph_04" {
+  hostname = "ceph-04"
+  addr     = "10.0.10.14"
+  labels   = ["osd", "rgw"]
+}
+
+# Host under repair: its daemons are stopped
+resource "ceph_host" "ceph_05" {
+  hostname    = "ceph-05"
+  addr        = "10.0.10.15"
+  labels      = ["osd"]
+  maintenance = true
+}
@@ -0,0 +1,2 @@
+# Manager modules can be imported using the module name
+terraform import ceph_mgr_module.prometheus prometheus
@@ -0,0 +1,21 @@
+resource "ceph_mgr_module" "prometheus" {
+  name = "prometheus"
+
+  options = {
+    server_port     = "9283"
+    scrape_interval = "15"
+  }
+}
+
+resource "ceph_mgr_module" "balancer" {
+  name = "balancer"
+
+  options = {
+    mode = "upmap"
+  }
+}
+
+resource "ceph_mgr_module" "telemetry" {
+  name    = "telemetry"
+  enabled = false
+}
@@ -0,0 +1,2 @@
+# NFS exports can be imported using <cluster_id>:<export_id>
+terraform import ceph_nfs_export.projects nfs-a:1
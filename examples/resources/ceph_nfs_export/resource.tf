@@ -0,0 +1,32 @@
+# CephFS share for legacy hosts, read-only except for the build servers
+resource "ceph_nfs_export" "projects" {
+  cluster_id  = "nfs-a"
+  pseudo      = "/projects"
+  path        = "/shares/projects"
+  access_type = "RO"
+  squash      = "root_squash"
+  protocols   = [4]
+
+  fsal = {
+    name    = "CEPH"
+    fs_name = "shared"
+  }
+
+  clients = [
+    {
+      addresses   = ["10.0.20.0/24"]
+      access_type = "RW"
+    },
+  ]
+}
+
+# RGW bucket exported over NFS
+resource "ceph_nfs_export" "backups" {
+  cluster_id = "nfs-a"
+  pseudo     = "/backups"
+  path       = ceph_rgw_bucket.backups.name
+
+  fsal = {
+    name = "RGW"
+  }
+}
@@ -0,0 +1,2 @@
+# Orchestrator services can be imported using the service name (<service_type>.<service_id> or <service_type>)
+terraform import ceph_orch_service.rgw rgw.default
@@ -0,0 +1,23 @@
+# RGW daemons on the hosts labelled "rgw"
+resource "ceph_orch_service" "rgw" {
+  service_type = "rgw"
+  service_id   = "default"
+
+  placement = {
+    label = "rgw"
+    count = 2
+  }
+
+  spec = jsonencode({
+    rgw_frontend_port = 8080
+  })
+}
+
+# Crash collector on every host
+resource "ceph_orch_service" "crash" {
+  service_type = "crash"
+
+  placement = {
+    hosts = ["ceph-01", "ceph-02", "ceph-03"]
+  }
+}
@@ -0,0 +1,2 @@
+# OSDs can be imported using the OSD number
+terraform import ceph_osd.osd_12 12
@@ -0,0 +1,9 @@
+# Drain osd.12 before replacing its failing disk: mark it out, wait for the
+# data to migrate, then destroy the resource. The OSD ID is kept for the
+# OSD deployed on the new disk.
+resource "ceph_osd" "osd_12" {
+  osd_id  = 12
+  out     = true
+  destroy = true
+  replace = true
+}
@@ -0,0 +1,2 @@
+# OSD device classes can be imported by OSD number
+terraform import ceph_osd_device_class.osd3 3
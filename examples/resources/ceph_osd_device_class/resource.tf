@@ -0,0 +1,7 @@
+# NVMe drives detected as ssd
+resource "ceph_osd_device_class" "osd" {
+  for_each = toset(["4", "5", "6"])
+
+  osd_id       = tonumber(each.value)
+  device_class = "nvme"
+}
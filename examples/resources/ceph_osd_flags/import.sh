@@ -0,0 +1,2 @@
+# The cluster-wide OSD flags can be imported using any ID
+terraform import ceph_osd_flags.maintenance cluster
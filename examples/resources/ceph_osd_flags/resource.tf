@@ -0,0 +1,4 @@
+# Maintenance window: keep OSDs in and avoid data movement while hosts reboot
+resource "ceph_osd_flags" "maintenance" {
+  flags = ["noout", "norebalance"]
+}
@@ -0,0 +1,2 @@
+# OSD settings can be imported using the OSD number
+terraform import ceph_osd_settings.osd_7 7
@@ -0,0 +1,12 @@
+# Slow disk: move part of its data away
+resource "ceph_osd_settings" "osd_7" {
+  osd_id   = 7
+  reweight = 0.8
+}
+
+# Disk being swapped: keep the OSD in while it is down
+resource "ceph_osd_settings" "osd_12" {
+  osd_id       = 12
+  device_class = "ssd"
+  noout        = true
+}
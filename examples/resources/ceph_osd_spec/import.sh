@@ -0,0 +1,2 @@
+# OSD specifications can be imported using the service ID
+terraform import ceph_osd_spec.hdd hdd
@@ -0,0 +1,34 @@
+# HDD OSDs with their DB on the NVMe devices of the hosts labelled "osd"
+resource "ceph_osd_spec" "hdd" {
+  service_id = "hdd"
+
+  placement = {
+    label = "osd"
+  }
+
+  data_devices = {
+    rotational = true
+  }
+
+  db_devices = {
+    rotational = false
+    size       = ":2T"
+  }
+
+  encrypted = true
+}
+
+# Two OSDs per NVMe device on dedicated hosts
+resource "ceph_osd_spec" "nvme" {
+  service_id = "nvme"
+
+  placement = {
+    hosts = ["ceph-07", "ceph-08"]
+  }
+
+  data_devices = {
+    paths = ["/dev/nvme0n1", "/dev/nvme1n1"]
+  }
+
+  osds_per_device = 2
+}
@@ -0,0 +1,2 @@
+# Image mirroring can be imported using <pool>/<image>
+terraform import ceph_rbd_image_mirroring.db kubernetes-rbd/db-volume
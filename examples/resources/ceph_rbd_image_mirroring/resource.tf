@@ -0,0 +1,7 @@
+# Snapshot-based mirroring with an hourly mirror snapshot
+resource "ceph_rbd_image_mirroring" "db" {
+  pool              = ceph_rbd_mirror_pool.kubernetes.pool
+  image             = "db-volume"
+  mode              = "snapshot"
+  schedule_interval = "1h"
+}
@@ -0,0 +1,4 @@
+# Token created on site A, imported on site B with ceph_rbd_mirror_peer
+resource "ceph_rbd_mirror_bootstrap_token" "site_a" {
+  pool = ceph_rbd_mirror_pool.kubernetes.pool
+}
@@ -0,0 +1,2 @@
+# Peers can be imported using <pool>:<uuid>
+terraform import ceph_rbd_mirror_peer.site_a kubernetes-rbd:6c37ad2d-3d3c-4a3a-b0d3-4e1f5c0f5e21
@@ -0,0 +1,7 @@
+# On site B, using a provider alias configured for that cluster
+resource "ceph_rbd_mirror_peer" "site_a" {
+  provider  = ceph.site_b
+  pool      = "kubernetes-rbd"
+  token     = ceph_rbd_mirror_bootstrap_token.site_a.token
+  direction = "rx-tx"
+}
@@ -0,0 +1,2 @@
+# Pool mirroring can be imported using the pool name
+terraform import ceph_rbd_mirror_pool.kubernetes kubernetes-rbd
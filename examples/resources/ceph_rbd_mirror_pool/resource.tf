@@ -0,0 +1,4 @@
+resource "ceph_rbd_mirror_pool" "kubernetes" {
+  pool = ceph_pool.kubernetes.name
+  mode = "image"
+}
@@ -0,0 +1,2 @@
+# The site name can be imported using its current value
+terraform import ceph_rbd_mirror_site_name.this site-a
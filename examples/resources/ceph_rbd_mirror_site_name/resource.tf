@@ -0,0 +1,3 @@
+resource "ceph_rbd_mirror_site_name" "this" {
+  site_name = "site-a"
+}
@@ -0,0 +1,2 @@
+# RGW buckets can be imported using their name
+terraform import ceph_rgw_bucket.backups acme-backups
@@ -0,0 +1,37 @@
+# Versioned backup bucket with object lock, encryption and lifecycle
+resource "ceph_rgw_bucket" "backups" {
+  name       = "acme-backups"
+  owner      = ceph_rgw_user.alice.id
+  versioning = true
+
+  object_lock_enabled        = true
+  object_lock_mode           = "GOVERNANCE"
+  object_lock_retention_days = 30
+
+  encryption = "AES256"
+
+  lifecycle_policy = jsonencode({
+    Rules = [{
+      ID     = "expire-noncurrent"
+      Status = "Enabled"
+      Filter = { Prefix = "" }
+      NoncurrentVersionExpiration = {
+        NoncurrentDays = 90
+      }
+    }]
+  })
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { AWS = ["arn:aws:iam::acme:user/backup-reader"] }
+      Action    = ["s3:GetObject", "s3:ListBucket"]
+      Resource  = ["arn:aws:s3:::acme-backups", "arn:aws:s3:::acme-backups/*"]
+    }]
+  })
+
+  tags = {
+    team = "platform"
+  }
+}
@@ -0,0 +1,2 @@
+# RGW realms can be imported using their name
+terraform import ceph_rgw_realm.acme acme
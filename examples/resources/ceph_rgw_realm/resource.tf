@@ -0,0 +1,4 @@
+resource "ceph_rgw_realm" "acme" {
+  name    = "acme"
+  default = true
+}
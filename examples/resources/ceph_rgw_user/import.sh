@@ -0,0 +1,2 @@
+# RGW users can be imported using their full user ID, prefixed by the tenant if any
+terraform import ceph_rgw_user.alice 'acme$alice'
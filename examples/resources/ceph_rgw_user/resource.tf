@@ -0,0 +1,16 @@
+# Object storage tenant with quotas
+resource "ceph_rgw_user" "alice" {
+  tenant       = "acme"
+  uid          = "alice"
+  display_name = "Alice (ACME)"
+  email        = "alice@acme.example"
+  max_buckets  = 10
+
+  user_quota = {
+    max_size_kb = 104857600 # 100 GiB
+  }
+
+  bucket_quota = {
+    max_objects = 1000000
+  }
+}
@@ -0,0 +1,2 @@
+# RGW user keys can be imported using <user>:<access_key>
+terraform import ceph_rgw_user_key.alice 'acme$alice:0555b35654ad1656d804'
@@ -0,0 +1,20 @@
+# Generated S3 key pair
+resource "ceph_rgw_user_key" "alice" {
+  user = ceph_rgw_user.alice.id
+}
+
+output "alice_access_key" {
+  value = ceph_rgw_user_key.alice.access_key
+}
+
+output "alice_secret_key" {
+  value     = ceph_rgw_user_key.alice.secret_key
+  sensitive = true
+}
+
+# Existing key pair migrated from another S3 store
+resource "ceph_rgw_user_key" "alice_legacy" {
+  user       = ceph_rgw_user.alice.id
+  access_key = var.legacy_access_key
+  secret_key = var.legacy_secret_key
+}
@@ -0,0 +1,2 @@
+# RGW zones can be imported using <realm>:<zonegroup>:<zone>
+terraform import ceph_rgw_zone.site_a acme:eu:site-a
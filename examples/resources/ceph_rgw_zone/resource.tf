@@ -0,0 +1,31 @@
+# System user used by the zones to synchronize
+resource "ceph_rgw_user" "sync" {
+  uid          = "sync"
+  display_name = "Multisite synchronization"
+  system       = true
+}
+
+resource "ceph_rgw_user_key" "sync" {
+  user = ceph_rgw_user.sync.id
+}
+
+resource "ceph_rgw_zone" "site_a" {
+  name      = "site-a"
+  zonegroup = ceph_rgw_zonegroup.eu.name
+  realm     = ceph_rgw_realm.acme.name
+  master    = true
+  default   = true
+  endpoints = ["http://rgw.site-a.example.com:8080"]
+
+  system_access_key = ceph_rgw_user_key.sync.access_key
+  system_secret_key = ceph_rgw_user_key.sync.secret_key
+
+  placement_pools = [
+    {
+      placement_target = "archive"
+      data_pool        = "site-a.rgw.archive.data"
+      index_pool       = "site-a.rgw.buckets.index"
+      data_extra_pool  = "site-a.rgw.buckets.non-ec"
+    },
+  ]
+}
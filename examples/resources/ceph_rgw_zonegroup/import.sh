@@ -0,0 +1,2 @@
+# RGW zonegroups can be imported using <realm>:<zonegroup>
+terraform import ceph_rgw_zonegroup.eu acme:eu
@@ -0,0 +1,12 @@
+resource "ceph_rgw_zonegroup" "eu" {
+  name      = "eu"
+  realm     = ceph_rgw_realm.acme.name
+  master    = true
+  default   = true
+  endpoints = ["http://rgw.site-a.example.com:8080"]
+
+  placement_targets = [
+    { name = "default-placement" },
+    { name = "archive", tags = ["archive"] },
+  ]
+}
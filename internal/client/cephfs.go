@@ -0,0 +1,139 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// CephFSMDSMap represents the MDS map of a CephFS file system
+type CephFSMDSMap struct {
+	FsName             string `json:"fs_name"`
+	MaxMDS             int    `json:"max_mds"`
+	StandbyCountWanted int    `json:"standby_count_wanted"`
+	DataPools          []int  `json:"data_pools"`
+	MetadataPool       int    `json:"metadata_pool"`
+	FlagsState         struct {
+		AllowStandbyReplay bool `json:"allow_standby_replay"`
+	} `json:"flags_state"`
+}
+
+// CephFS represents a CephFS file system from the FS map
+type CephFS struct {
+	ID     int          `json:"id"`
+	MDSMap CephFSMDSMap `json:"mdsmap"`
+}
+
+// CephFSPool represents a pool used by a CephFS file system
+type CephFSPool struct {
+	Pool string `json:"pool"`
+	Type string `json:"type"`
+}
+
+// CephFSDetails represents the response from /api/cephfs/{fs_id}
+type CephFSDetails struct {
+	CephFS struct {
+		ID    int          `json:"id"`
+		Name  string       `json:"name"`
+		Pools []CephFSPool `json:"pools"`
+	} `json:"cephfs"`
+}
+
+// CephFSCreate represents the payload for creating a CephFS volume
+type CephFSCreate struct {
+	Name         string      `json:"name"`
+	ServiceSpec  ServiceSpec `json:"service_spec"`
+	DataPool     string      `json:"data_pool,omitempty"`
+	MetadataPool string      `json:"metadata_pool,omitempty"`
+}
+
+// ListCephFS retrieves all CephFS file systems
+func (c *Client) ListCephFS() ([]CephFS, error) {
+	resp, err := c.DoRequest("GET", "/api/cephfs", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var filesystems []CephFS
+	err = json.Unmarshal(resp, &filesystems)
+	if err != nil {
+		return nil, err
+	}
+
+	return filesystems, nil
+}
+
+// GetCephFS retrieves a CephFS file system by name
+func (c *Client) GetCephFS(name string) (*CephFS, error) {
+	filesystems, err := c.ListCephFS()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fs := range filesystems {
+		if fs.MDSMap.FsName == name {
+			return &fs, nil
+		}
+	}
+
+	return nil, fmt.Errorf("cephfs %s %w", name, ErrNotFound)
+}
+
+// GetCephFSPools retrieves the data and metadata pool names of a file system
+func (c *Client) GetCephFSPools(id int) (dataPools []string, metadataPool string, err error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/cephfs/%d", id), nil)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var details CephFSDetails
+	err = json.Unmarshal(resp, &details)
+	if err != nil {
+		return nil, "", err
+	}
+
+	dataPools = []string{}
+	for _, p := range details.CephFS.Pools {
+		switch p.Type {
+		case "metadata":
+			metadataPool = p.Pool
+		case "data":
+			dataPools = append(dataPools, p.Pool)
+		}
+	}
+
+	return dataPools, metadataPool, nil
+}
+
+// CreateCephFS creates a new CephFS volume, including its MDS service
+func (c *Client) CreateCephFS(fs CephFSCreate) error {
+	rb, err := json.Marshal(fs)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/cephfs", bytes.NewBuffer(rb))
+	return err
+}
+
+// SetCephFSOption changes a file system setting (ceph fs set <name> <var> <val>)
+func (c *Client) SetCephFSOption(name, option, value string) error {
+	payload := map[string]string{
+		"var": option,
+		"val": value,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/cephfs/%s/set", url.PathEscape(name)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteCephFS deletes a CephFS volume and its pools
+func (c *Client) DeleteCephFS(name string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/cephfs/remove/%s", url.PathEscape(name)), nil)
+	return err
+}
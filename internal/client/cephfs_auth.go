@@ -0,0 +1,89 @@
+package client
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CephFSPathAccess represents the access of a client to a CephFS path
+type CephFSPathAccess struct {
+	Path       string
+	Permission string
+	RootSquash bool
+}
+
+// BuildCephFSCapabilities creates the capabilities that "ceph fs authorize" would
+// grant for the given file system and paths.
+func BuildCephFSCapabilities(fsName string, paths []CephFSPathAccess) []Capability {
+	var grants []string
+	osdPerm := "r"
+	for _, p := range paths {
+		// MDS capabilities are "allow <perm> fsname=<fs> path=<path> root_squash"
+		grant := fmt.Sprintf("allow %s fsname=%s", p.Permission, fsName)
+		if p.Path != "" && p.Path != "/" {
+			grant += fmt.Sprintf(" path=%s", p.Path)
+		}
+		if p.RootSquash {
+			grant += " root_squash"
+		}
+		grants = append(grants, grant)
+
+		if strings.Contains(p.Permission, "w") {
+			osdPerm = "rw"
+		}
+	}
+
+	return []Capability{
+		{Entity: "mds", Cap: strings.Join(grants, ", ")},
+		{Entity: "mon", Cap: fmt.Sprintf("allow r fsname=%s", fsName)},
+		{Entity: "osd", Cap: fmt.Sprintf("allow %s tag cephfs data=%s", osdPerm, fsName)},
+	}
+}
+
+// ParseCephFSCapabilities parses an MDS capability string as generated by
+// BuildCephFSCapabilities back into the file system name and path access list.
+func ParseCephFSCapabilities(mdsCap string) (string, []CephFSPathAccess, error) {
+	var fsName string
+	var paths []CephFSPathAccess
+
+	for _, grant := range strings.Split(mdsCap, ",") {
+		fields := strings.Fields(grant)
+		if len(fields) < 2 || fields[0] != "allow" {
+			return "", nil, fmt.Errorf("unsupported mds capability: %q", strings.TrimSpace(grant))
+		}
+
+		access := CephFSPathAccess{Path: "/", Permission: fields[1]}
+		grantFs := ""
+		// Options follow the order of the grammar: fsname, path, root_squash
+		order := 0
+		for _, field := range fields[2:] {
+			key, value, _ := strings.Cut(field, "=")
+			position := slices.Index([]string{"fsname", "path", "root_squash"}, key)
+			if position < 0 {
+				return "", nil, fmt.Errorf("unsupported mds capability option: %q", field)
+			}
+			if position < order {
+				return "", nil, fmt.Errorf("mds capability option %q is out of order in %q", field, strings.TrimSpace(grant))
+			}
+			order = position + 1
+
+			switch key {
+			case "fsname":
+				grantFs = value
+			case "path":
+				access.Path = value
+			case "root_squash":
+				access.RootSquash = true
+			}
+		}
+
+		if grantFs == "" || (fsName != "" && grantFs != fsName) {
+			return "", nil, fmt.Errorf("mds capability must grant access to a single file system")
+		}
+		fsName = grantFs
+		paths = append(paths, access)
+	}
+
+	return fsName, paths, nil
+}
@@ -0,0 +1,47 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuildCephFSCapabilities(t *testing.T) {
+	caps := BuildCephFSCapabilities("shared", []CephFSPathAccess{
+		{Path: "/volumes/csi", Permission: "rw"},
+		{Path: "/archive", Permission: "r", RootSquash: true},
+	})
+
+	want := []Capability{
+		{Entity: "mds", Cap: "allow rw fsname=shared path=/volumes/csi, allow r fsname=shared path=/archive root_squash"},
+		{Entity: "mon", Cap: "allow r fsname=shared"},
+		{Entity: "osd", Cap: "allow rw tag cephfs data=shared"},
+	}
+	if !reflect.DeepEqual(caps, want) {
+		t.Fatalf("got %+v, want %+v", caps, want)
+	}
+}
+
+func TestParseCephFSCapabilities(t *testing.T) {
+	paths := []CephFSPathAccess{
+		{Path: "/", Permission: "r"},
+		{Path: "/volumes/csi", Permission: "rwps", RootSquash: true},
+	}
+
+	fsName, parsed, err := ParseCephFSCapabilities(BuildCephFSCapabilities("shared", paths)[0].Cap)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if fsName != "shared" {
+		t.Errorf("fs name = %q, want %q", fsName, "shared")
+	}
+	if !reflect.DeepEqual(parsed, paths) {
+		t.Errorf("got %+v, want %+v", parsed, paths)
+	}
+
+	if _, _, err := ParseCephFSCapabilities("allow rw fsname=a, allow r fsname=b"); err == nil {
+		t.Error("expected an error for capabilities spanning file systems")
+	}
+	if _, _, err := ParseCephFSCapabilities("allow r fsname=shared root_squash path=/archive"); err == nil {
+		t.Error("expected an error for root_squash before the path")
+	}
+}
@@ -0,0 +1,179 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CephFSSnapshotSchedule represents a snapshot schedule of a CephFS path
+type CephFSSnapshotSchedule struct {
+	Fs        string         `json:"fs"`
+	Path      string         `json:"path"`
+	Schedule  string         `json:"schedule"`
+	Start     string         `json:"start,omitempty"`
+	Retention map[string]int `json:"retention"`
+	Active    bool           `json:"active"`
+}
+
+// CephFSQuotas represents the quotas of a CephFS directory
+type CephFSQuotas struct {
+	MaxBytes int64 `json:"max_bytes"`
+	MaxFiles int64 `json:"max_files"`
+}
+
+// FormatRetention converts a retention map (e.g. {"d": 7, "w": 4}) into the
+// retention spec expected by the snap_schedule module (e.g. "7d4w").
+func FormatRetention(retention map[string]int) string {
+	periods := make([]string, 0, len(retention))
+	for period := range retention {
+		periods = append(periods, period)
+	}
+	sort.Strings(periods)
+
+	var b strings.Builder
+	for _, period := range periods {
+		fmt.Fprintf(&b, "%d%s", retention[period], period)
+	}
+	return b.String()
+}
+
+// ListCephFSSnapshotSchedules retrieves the snapshot schedules defined on a path
+func (c *Client) ListCephFSSnapshotSchedules(fsName, path string) ([]CephFSSnapshotSchedule, error) {
+	query := url.Values{"fs": {fsName}, "path": {path}}
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/cephfs/snapshot/schedule?%s", query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []CephFSSnapshotSchedule
+	err = json.Unmarshal(resp, &schedules)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+// GetCephFSSnapshotSchedule retrieves a snapshot schedule by path and schedule
+func (c *Client) GetCephFSSnapshotSchedule(fsName, path, schedule string) (*CephFSSnapshotSchedule, error) {
+	schedules, err := c.ListCephFSSnapshotSchedules(fsName, path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range schedules {
+		if s.Path == path && s.Schedule == schedule {
+			return &s, nil
+		}
+	}
+
+	return nil, fmt.Errorf("snapshot schedule %s on %s %w", schedule, path, ErrNotFound)
+}
+
+// CreateCephFSSnapshotSchedule adds a snapshot schedule to a path
+func (c *Client) CreateCephFSSnapshotSchedule(schedule CephFSSnapshotSchedule) error {
+	payload := map[string]string{
+		"fs":               schedule.Fs,
+		"path":             schedule.Path,
+		"snap_schedule":    schedule.Schedule,
+		"start":            schedule.Start,
+		"retention_policy": FormatRetention(schedule.Retention),
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/cephfs/snapshot/schedule", bytes.NewBuffer(rb))
+	return err
+}
+
+// UpdateCephFSSnapshotRetention replaces the retention policy of the snapshot schedules on a path
+func (c *Client) UpdateCephFSSnapshotRetention(fsName, path string, remove, add map[string]int) error {
+	payload := map[string]string{
+		"path":                path,
+		"retention_to_remove": FormatRetention(remove),
+		"retention_to_add":    FormatRetention(add),
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/cephfs/snapshot/schedule/%s", url.PathEscape(fsName)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteCephFSSnapshotSchedule removes a snapshot schedule from a path
+func (c *Client) DeleteCephFSSnapshotSchedule(schedule CephFSSnapshotSchedule) error {
+	query := url.Values{
+		"path":             {schedule.Path},
+		"schedule":         {schedule.Schedule},
+		"start":            {schedule.Start},
+		"retention_policy": {FormatRetention(schedule.Retention)},
+	}
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/cephfs/snapshot/schedule/%s?%s", url.PathEscape(schedule.Fs), query.Encode()), nil)
+	return err
+}
+
+// GetCephFSQuotas retrieves the quotas of a directory
+func (c *Client) GetCephFSQuotas(fsID int, path string) (*CephFSQuotas, error) {
+	query := url.Values{"path": {path}}
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/cephfs/%d/quota?%s", fsID, query.Encode()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var quotas CephFSQuotas
+	err = json.Unmarshal(resp, &quotas)
+	if err != nil {
+		return nil, err
+	}
+
+	return &quotas, nil
+}
+
+// SetCephFSQuotas sets the quotas of a directory. A value of 0 removes the quota.
+func (c *Client) SetCephFSQuotas(fsID int, path string, quotas CephFSQuotas) error {
+	payload := map[string]interface{}{
+		"path":      path,
+		"max_bytes": quotas.MaxBytes,
+		"max_files": quotas.MaxFiles,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/cephfs/%d/quota", fsID), bytes.NewBuffer(rb))
+	return err
+}
+
+// snapshotPeriods lists the periods accepted by the snap_schedule module
+const snapshotPeriods = "mhdwMy"
+
+// ValidateSnapshotSchedule validates a schedule (e.g. "1h") and its retention spec
+func ValidateSnapshotSchedule(schedule string, retention map[string]int) error {
+	if len(schedule) < 2 || !strings.ContainsRune(snapshotPeriods, rune(schedule[len(schedule)-1])) {
+		return fmt.Errorf("invalid schedule %q: expected a number followed by one of %s", schedule, snapshotPeriods)
+	}
+	if n, err := strconv.Atoi(schedule[:len(schedule)-1]); err != nil || n <= 0 {
+		return fmt.Errorf("invalid schedule %q: expected a positive number followed by one of %s", schedule, snapshotPeriods)
+	}
+
+	for period, count := range retention {
+		if len(period) != 1 || !strings.Contains(snapshotPeriods+"n", period) {
+			return fmt.Errorf("invalid retention period %q: expected one of %sn", period, snapshotPeriods)
+		}
+		if count <= 0 {
+			return fmt.Errorf("invalid retention count %d for period %q: must be positive", count, period)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package client
+
+import "testing"
+
+func TestFormatRetention(t *testing.T) {
+	got := FormatRetention(map[string]int{"w": 4, "d": 7, "h": 24})
+	if got != "7d24h4w" {
+		t.Errorf("got %q, want %q", got, "7d24h4w")
+	}
+	if got := FormatRetention(nil); got != "" {
+		t.Errorf("got %q, want empty spec", got)
+	}
+}
+
+func TestValidateSnapshotSchedule(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedule  string
+		retention map[string]int
+		wantErr   bool
+	}{
+		{name: "hourly", schedule: "1h", retention: map[string]int{"h": 24, "d": 7}},
+		{name: "monthly", schedule: "1M"},
+		{name: "keep last n", schedule: "30m", retention: map[string]int{"n": 10}},
+		{name: "missing period", schedule: "12", wantErr: true},
+		{name: "unknown period", schedule: "1s", wantErr: true},
+		{name: "zero interval", schedule: "0d", wantErr: true},
+		{name: "unknown retention period", schedule: "1h", retention: map[string]int{"x": 1}, wantErr: true},
+		{name: "zero retention", schedule: "1h", retention: map[string]int{"d": 0}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSnapshotSchedule(tt.schedule, tt.retention)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateSnapshotSchedule(%q) error = %v, wantErr %v", tt.schedule, err, tt.wantErr)
+			}
+		})
+	}
+}
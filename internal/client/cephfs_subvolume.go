@@ -0,0 +1,176 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CephFSQuota is a byte quota as reported by the volumes module, where an
+// unlimited quota is reported as "infinite". Unlimited quotas are decoded as 0.
+type CephFSQuota int64
+
+// UnmarshalJSON decodes both numeric quotas and "infinite"
+func (q *CephFSQuota) UnmarshalJSON(b []byte) error {
+	s := strings.Trim(string(b), "\"")
+	if s == "infinite" || s == "null" {
+		*q = 0
+		return nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return err
+	}
+	*q = CephFSQuota(v)
+	return nil
+}
+
+// CephFSSubvolumeRequest represents the payload for creating a subvolume or subvolume group
+type CephFSSubvolumeRequest struct {
+	VolName        string `json:"vol_name"`
+	SubvolName     string `json:"subvol_name,omitempty"`
+	GroupName      string `json:"group_name,omitempty"`
+	Size           int64  `json:"size,omitempty"`
+	PoolLayout     string `json:"pool_layout,omitempty"`
+	UID            *int64 `json:"uid,omitempty"`
+	GID            *int64 `json:"gid,omitempty"`
+	Mode           string `json:"mode,omitempty"`
+	IsolatedNspace bool   `json:"isolated_nspace,omitempty"`
+}
+
+// CephFSSubvolumeInfo represents the response of subvolume and subvolume group info
+type CephFSSubvolumeInfo struct {
+	Path          string      `json:"path"`
+	BytesQuota    CephFSQuota `json:"bytes_quota"`
+	BytesUsed     int64       `json:"bytes_used"`
+	Mode          int         `json:"mode"`
+	UID           int64       `json:"uid"`
+	GID           int64       `json:"gid"`
+	DataPool      string      `json:"data_pool"`
+	PoolNamespace string      `json:"pool_namespace"`
+}
+
+// PermissionBits returns the permission bits of the mode as an octal string (e.g. "755")
+func (i *CephFSSubvolumeInfo) PermissionBits() string {
+	return strconv.FormatInt(int64(i.Mode&0o7777), 8)
+}
+
+// getSubvolumeInfo retrieves info from a subvolume or subvolume group info endpoint
+func (c *Client) getSubvolumeInfo(endpoint string, query url.Values) (*CephFSSubvolumeInfo, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("%s?%s", endpoint, query.Encode()), nil)
+	if err != nil {
+		// The volumes module reports missing subvolumes as ENOENT errors
+		if !errors.Is(err, ErrNotFound) && strings.Contains(err.Error(), "does not exist") {
+			return nil, fmt.Errorf("%s: %w", err, ErrNotFound)
+		}
+		return nil, err
+	}
+
+	var info CephFSSubvolumeInfo
+	err = json.Unmarshal(resp, &info)
+	if err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
+
+// CreateCephFSSubvolumeGroup creates a subvolume group
+func (c *Client) CreateCephFSSubvolumeGroup(req CephFSSubvolumeRequest) error {
+	rb, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/cephfs/subvolume/group", bytes.NewBuffer(rb))
+	return err
+}
+
+// GetCephFSSubvolumeGroup retrieves info about a subvolume group
+func (c *Client) GetCephFSSubvolumeGroup(volume, group string) (*CephFSSubvolumeInfo, error) {
+	query := url.Values{"group_name": {group}}
+	return c.getSubvolumeInfo(fmt.Sprintf("/api/cephfs/subvolume/group/%s/info", url.PathEscape(volume)), query)
+}
+
+// ResizeCephFSSubvolumeGroup changes the quota of a subvolume group. A size of 0 removes the quota.
+func (c *Client) ResizeCephFSSubvolumeGroup(volume, group string, size int64) error {
+	payload := map[string]interface{}{
+		"group_name": group,
+		"size":       quotaValue(size),
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/cephfs/subvolume/group/%s", url.PathEscape(volume)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteCephFSSubvolumeGroup deletes an empty subvolume group
+func (c *Client) DeleteCephFSSubvolumeGroup(volume, group string) error {
+	query := url.Values{"group_name": {group}}
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/cephfs/subvolume/group/%s?%s", url.PathEscape(volume), query.Encode()), nil)
+	return err
+}
+
+// CreateCephFSSubvolume creates a subvolume
+func (c *Client) CreateCephFSSubvolume(req CephFSSubvolumeRequest) error {
+	rb, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/cephfs/subvolume", bytes.NewBuffer(rb))
+	return err
+}
+
+// GetCephFSSubvolume retrieves info about a subvolume, including its path
+func (c *Client) GetCephFSSubvolume(volume, group, subvolume string) (*CephFSSubvolumeInfo, error) {
+	query := url.Values{"subvol_name": {subvolume}}
+	if group != "" {
+		query.Set("group_name", group)
+	}
+	return c.getSubvolumeInfo(fmt.Sprintf("/api/cephfs/subvolume/%s/info", url.PathEscape(volume)), query)
+}
+
+// ResizeCephFSSubvolume changes the quota of a subvolume. A size of 0 removes the quota.
+func (c *Client) ResizeCephFSSubvolume(volume, group, subvolume string, size int64) error {
+	payload := map[string]interface{}{
+		"subvol_name": subvolume,
+		"size":        quotaValue(size),
+	}
+	if group != "" {
+		payload["group_name"] = group
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/cephfs/subvolume/%s", url.PathEscape(volume)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteCephFSSubvolume deletes a subvolume
+func (c *Client) DeleteCephFSSubvolume(volume, group, subvolume string) error {
+	query := url.Values{"subvol_name": {subvolume}}
+	if group != "" {
+		query.Set("group_name", group)
+	}
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/cephfs/subvolume/%s?%s", url.PathEscape(volume), query.Encode()), nil)
+	return err
+}
+
+// quotaValue converts a size in bytes to the value expected by the volumes
+// module, where 0 means no quota.
+func quotaValue(size int64) interface{} {
+	if size == 0 {
+		return "infinite"
+	}
+	return size
+}
@@ -4,17 +4,40 @@ import (
 	"bytes"
 	"crypto/tls"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"time"
 )
 
+// ErrNotFound is returned (wrapped) when a requested object does not exist
+var ErrNotFound = errors.New("not found")
+
+// APIError is returned when the Dashboard API responds with a non-2xx status.
+// A 404 response matches ErrNotFound with errors.Is.
+type APIError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("status: %d, body: %s", e.StatusCode, e.Body)
+}
+
+// Is reports whether the error is a not found error
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
 // Client holds the connection details
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	Token      string
+	// HealthGate, when set, refuses mutating requests to an unhealthy cluster
+	HealthGate *HealthGate
 }
 
 // AuthResponse represents the response from the login endpoint
@@ -94,6 +117,12 @@ func (c *Client) DoRequest(method, endpoint string, body io.Reader) ([]byte, err
 
 // DoRequestWithHeaders performs the HTTP request with custom headers
 func (c *Client) DoRequestWithHeaders(method, endpoint string, body io.Reader, headers map[string]string) ([]byte, error) {
+	if method != http.MethodGet && c.HealthGate != nil && !slices.Contains(healthGateExemptEndpoints, endpoint) {
+		if err := c.HealthGate.check(c); err != nil {
+			return nil, err
+		}
+	}
+
 	req, err := http.NewRequest(method, fmt.Sprintf("%s%s", c.HostURL, endpoint), body)
 	if err != nil {
 		return nil, err
@@ -123,7 +152,7 @@ func (c *Client) DoRequestWithHeaders(method, endpoint string, body io.Reader, h
 	}
 
 	if res.StatusCode < 200 || res.StatusCode >= 300 {
-		return nil, fmt.Errorf("status: %d, body: %s", res.StatusCode, string(respBody))
+		return nil, &APIError{StatusCode: res.StatusCode, Body: string(respBody)}
 	}
 
 	return respBody, nil
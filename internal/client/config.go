@@ -0,0 +1,284 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ConfigValue represents a value of a configuration option for a section
+// (who) of the configuration database
+type ConfigValue struct {
+	Section string `json:"section"`
+	Mask    string `json:"mask,omitempty"`
+	Value   string `json:"value"`
+}
+
+// ConfigOption represents a configuration option along with the values set in
+// the configuration database
+type ConfigOption struct {
+	Name               string          `json:"name"`
+	Type               string          `json:"type"`
+	Level              string          `json:"level"`
+	Desc               string          `json:"desc"`
+	LongDesc           string          `json:"long_desc"`
+	Default            json.RawMessage `json:"default"`
+	DaemonDefault      json.RawMessage `json:"daemon_default"`
+	EnumValues         []string        `json:"enum_values"`
+	Min                json.RawMessage `json:"min"`
+	Max                json.RawMessage `json:"max"`
+	CanUpdateAtRuntime bool            `json:"can_update_at_runtime"`
+	Services           []string        `json:"services"`
+	Value              []ConfigValue   `json:"value"`
+}
+
+// RawString returns a JSON scalar as a string: strings are unquoted, numbers
+// and booleans are kept as written, null is empty
+func RawString(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	return string(raw)
+}
+
+// ConfigWho returns the target of a configuration value, i.e. its section
+// with the optional mask (e.g. osd/class:ssd)
+func ConfigWho(section, mask string) string {
+	if mask == "" {
+		return section
+	}
+	return section + "/" + mask
+}
+
+// FindConfigValue returns the value of an option set for a section and mask.
+// Depending on the Ceph version, masked values are reported with a separate
+// mask or with the mask appended to the section. Older versions drop the mask
+// entirely; a masked value is then only found when a value of the section is
+// equivalent to expected.
+func FindConfigValue(option *ConfigOption, section, mask, expected string) (string, bool) {
+	who := ConfigWho(section, mask)
+	for _, v := range option.Value {
+		if ConfigWho(v.Section, v.Mask) == who {
+			return v.Value, true
+		}
+	}
+
+	if mask != "" {
+		for _, v := range option.Value {
+			if v.Section == section && v.Mask == "" && ConfigValuesEqual(option, v.Value, expected) {
+				return v.Value, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// configSizeUnits are the powers of 1024 of the size units accepted by Ceph
+var configSizeUnits = map[string]float64{
+	"": 1, "b": 1,
+	"k": 1 << 10, "ki": 1 << 10, "kb": 1 << 10, "kib": 1 << 10,
+	"m": 1 << 20, "mi": 1 << 20, "mb": 1 << 20, "mib": 1 << 20,
+	"g": 1 << 30, "gi": 1 << 30, "gb": 1 << 30, "gib": 1 << 30,
+	"t": 1 << 40, "ti": 1 << 40, "tb": 1 << 40, "tib": 1 << 40,
+	"p": 1 << 50, "pi": 1 << 50, "pb": 1 << 50, "pib": 1 << 50,
+	"e": 1 << 60, "ei": 1 << 60, "eb": 1 << 60, "eib": 1 << 60,
+}
+
+// configDurationUnits are the durations in seconds of the time units accepted by Ceph
+var configDurationUnits = map[string]float64{
+	"ms": 0.001, "msec": 0.001,
+	"s": 1, "sec": 1, "secs": 1,
+	"m": 60, "min": 60, "mins": 60,
+	"h": 3600, "hr": 3600, "hrs": 3600, "hour": 3600, "hours": 3600,
+	"d": 86400, "day": 86400, "days": 86400,
+	"w": 604800, "wk": 604800, "wks": 604800, "week": 604800, "weeks": 604800,
+	"mo": 2592000, "month": 2592000, "months": 2592000,
+	"y": 31536000, "yr": 31536000, "yrs": 31536000, "year": 31536000, "years": 31536000,
+}
+
+var configNumberUnitRe = regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*([a-z]*)$`)
+
+// parseConfigNumber parses a number with an optional unit. A missing unit
+// is worth unit.
+func parseConfigNumber(value string, units map[string]float64, unit float64) (float64, bool) {
+	match := configNumberUnitRe.FindStringSubmatch(strings.ToLower(strings.TrimSpace(value)))
+	if match == nil {
+		return 0, false
+	}
+	n, err := strconv.ParseFloat(match[1], 64)
+	if err != nil {
+		return 0, false
+	}
+	if match[2] == "" {
+		return n * unit, true
+	}
+	factor, ok := units[match[2]]
+	return n * factor, ok
+}
+
+// parseConfigBool parses a boolean the way Ceph does
+func parseConfigBool(value string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "true", "yes", "on":
+		return true, true
+	case "false", "no", "off":
+		return false, true
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	return n != 0, err == nil
+}
+
+// ConfigValuesEqual reports whether two values of an option are equivalent
+// once parsed by the type of the option. The monitor normalizes the values it
+// stores, e.g. 4G is stored as 4294967296, yes as true and 10m as 600.
+func ConfigValuesEqual(option *ConfigOption, a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	var na, nb float64
+	var oka, okb bool
+	switch option.Type {
+	case "size":
+		na, oka = parseConfigNumber(a, configSizeUnits, 1)
+		nb, okb = parseConfigNumber(b, configSizeUnits, 1)
+	case "secs":
+		na, oka = parseConfigNumber(a, configDurationUnits, 1)
+		nb, okb = parseConfigNumber(b, configDurationUnits, 1)
+	case "millisecs":
+		na, oka = parseConfigNumber(a, configDurationUnits, 0.001)
+		nb, okb = parseConfigNumber(b, configDurationUnits, 0.001)
+	case "int", "uint", "float":
+		var err error
+		na, err = strconv.ParseFloat(strings.TrimSpace(a), 64)
+		oka = err == nil
+		nb, err = strconv.ParseFloat(strings.TrimSpace(b), 64)
+		okb = err == nil
+	case "bool":
+		ba, oka := parseConfigBool(a)
+		bb, okb := parseConfigBool(b)
+		return oka && okb && ba == bb
+	default:
+		return false
+	}
+
+	return oka && okb && math.Abs(na-nb) <= 1e-9*math.Max(math.Abs(na), math.Abs(nb))
+}
+
+var (
+	configSizeRe     = regexp.MustCompile(`(?i)^\d+(\.\d+)?\s*([kmgtpe]i?b?|b)?$`)
+	configDurationRe = regexp.MustCompile(`(?i)^\d+(\.\d+)?\s*(ms|msec|s|sec|secs|m|min|mins|h|hr|hrs|hour|hours|d|day|days|w|wk|wks|week|weeks|mo|month|months|y|yr|yrs|year|years)?$`)
+	configUUIDRe     = regexp.MustCompile(`(?i)^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+)
+
+// ValidateConfigValue checks that value is valid for the type, allowed values
+// and bounds of the option
+func ValidateConfigValue(option *ConfigOption, value string) error {
+	if len(option.EnumValues) > 0 && !slices.Contains(option.EnumValues, value) {
+		return fmt.Errorf("%s must be one of %s, got: %q", option.Name, strings.Join(option.EnumValues, ", "), value)
+	}
+
+	var number float64
+	var err error
+	switch option.Type {
+	case "int":
+		var n int64
+		n, err = strconv.ParseInt(value, 10, 64)
+		number = float64(n)
+	case "uint":
+		var n uint64
+		n, err = strconv.ParseUint(value, 10, 64)
+		number = float64(n)
+	case "float":
+		number, err = strconv.ParseFloat(value, 64)
+	case "bool":
+		switch strings.ToLower(value) {
+		case "true", "false", "yes", "no", "on", "off":
+			return nil
+		}
+		if _, err := strconv.ParseInt(value, 10, 64); err != nil {
+			return fmt.Errorf("%s expects a boolean (true or false), got: %q", option.Name, value)
+		}
+		return nil
+	case "size":
+		if !configSizeRe.MatchString(value) {
+			return fmt.Errorf("%s expects a size (e.g., 4096, 4K, 4G), got: %q", option.Name, value)
+		}
+		return nil
+	case "secs", "millisecs":
+		if !configDurationRe.MatchString(value) {
+			return fmt.Errorf("%s expects a duration (e.g., 30, 30s, 5m, 1h), got: %q", option.Name, value)
+		}
+		return nil
+	case "uuid":
+		if !configUUIDRe.MatchString(value) {
+			return fmt.Errorf("%s expects a UUID, got: %q", option.Name, value)
+		}
+		return nil
+	default:
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%s expects a value of type %s, got: %q", option.Name, option.Type, value)
+	}
+
+	if min, err := strconv.ParseFloat(RawString(option.Min), 64); err == nil && number < min {
+		return fmt.Errorf("%s must be at least %s, got: %s", option.Name, RawString(option.Min), value)
+	}
+	if max, err := strconv.ParseFloat(RawString(option.Max), 64); err == nil && number > max {
+		return fmt.Errorf("%s must be at most %s, got: %s", option.Name, RawString(option.Max), value)
+	}
+
+	return nil
+}
+
+// GetConfigOption retrieves a configuration option and its values
+func (c *Client) GetConfigOption(name string) (*ConfigOption, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/cluster_conf/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var option ConfigOption
+	err = json.Unmarshal(resp, &option)
+	if err != nil {
+		return nil, err
+	}
+	if option.Name == "" {
+		return nil, fmt.Errorf("config option %s %w", name, ErrNotFound)
+	}
+
+	return &option, nil
+}
+
+// SetConfigValue sets a configuration option for a target (ceph config set)
+func (c *Client) SetConfigValue(name, who, value string) error {
+	payload := map[string]interface{}{
+		"name":  name,
+		"value": []ConfigValue{{Section: who, Value: value}},
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/cluster_conf", bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteConfigValue removes a configuration option for a target (ceph config rm)
+func (c *Client) DeleteConfigValue(name, who string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/cluster_conf/%s?section=%s", url.PathEscape(name), url.QueryEscape(who)), nil)
+	return err
+}
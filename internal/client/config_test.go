@@ -0,0 +1,148 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRawString(t *testing.T) {
+	tests := map[string]string{
+		`"4G"`:       "4G",
+		`4294967296`: "4294967296",
+		`0.5`:        "0.5",
+		`true`:       "true",
+		`null`:       "",
+		``:           "",
+	}
+
+	for input, want := range tests {
+		if got := RawString(json.RawMessage(input)); got != want {
+			t.Errorf("RawString(%s) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestFindConfigValue(t *testing.T) {
+	// The monitor stores normalized values: 4G as 4294967296 and 8G as 8589934592
+	separate := []ConfigValue{
+		{Section: "osd", Value: "4294967296"},
+		{Section: "osd", Mask: "class:ssd", Value: "8589934592"},
+	}
+	combined := []ConfigValue{
+		{Section: "osd", Value: "4294967296"},
+		{Section: "osd/class:ssd", Value: "8589934592"},
+	}
+	dropped := []ConfigValue{
+		{Section: "osd", Value: "4294967296"},
+		{Section: "osd", Value: "8589934592"},
+	}
+
+	tests := []struct {
+		name     string
+		values   []ConfigValue
+		section  string
+		mask     string
+		expected string
+		want     string
+		found    bool
+	}{
+		{"unmasked", separate, "osd", "", "4G", "4294967296", true},
+		{"separate mask", separate, "osd", "class:ssd", "8G", "8589934592", true},
+		{"combined mask", combined, "osd", "class:ssd", "8G", "8589934592", true},
+		{"dropped mask", dropped, "osd", "class:ssd", "8G", "8589934592", true},
+		{"dropped mask bytes", dropped, "osd", "class:ssd", "8589934592", "8589934592", true},
+		{"dropped mask changed", dropped, "osd", "class:ssd", "16G", "", false},
+		{"other section", separate, "mon", "", "4G", "", false},
+		{"other mask", separate, "osd", "host:ceph-01", "8G", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			option := &ConfigOption{Name: "osd_memory_target", Type: "size", Value: tt.values}
+			got, found := FindConfigValue(option, tt.section, tt.mask, tt.expected)
+			if got != tt.want || found != tt.found {
+				t.Errorf("got (%q, %v), want (%q, %v)", got, found, tt.want, tt.found)
+			}
+		})
+	}
+}
+
+func TestConfigValuesEqual(t *testing.T) {
+	tests := []struct {
+		typ   string
+		a, b  string
+		equal bool
+	}{
+		{"size", "4G", "4294967296", true},
+		{"size", "512MiB", "536870912", true},
+		{"size", "4G", "4000000000", false},
+		{"secs", "10m", "600", true},
+		{"secs", "1h", "3600", true},
+		{"secs", "10m", "60", false},
+		{"millisecs", "2s", "2000", true},
+		{"bool", "yes", "true", true},
+		{"bool", "off", "false", true},
+		{"bool", "1", "true", true},
+		{"bool", "yes", "false", false},
+		{"int", "010", "10", true},
+		{"uint", "3", "4", false},
+		{"float", "0.50", "0.5", true},
+		{"str", "lz4", "lz4", true},
+		{"str", "LZ4", "lz4", false},
+		{"size", "four", "4", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.typ+" "+tt.a+" "+tt.b, func(t *testing.T) {
+			option := &ConfigOption{Name: "test_option", Type: tt.typ}
+			if got := ConfigValuesEqual(option, tt.a, tt.b); got != tt.equal {
+				t.Errorf("ConfigValuesEqual(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.equal)
+			}
+		})
+	}
+}
+
+func TestValidateConfigValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		option  ConfigOption
+		value   string
+		wantErr bool
+	}{
+		{"uint", ConfigOption{Type: "uint"}, "3", false},
+		{"uint negative", ConfigOption{Type: "uint"}, "-3", true},
+		{"int", ConfigOption{Type: "int"}, "-3", false},
+		{"int text", ConfigOption{Type: "int"}, "three", true},
+		{"int below min", ConfigOption{Type: "int", Min: json.RawMessage(`1`)}, "0", true},
+		{"int above max", ConfigOption{Type: "int", Max: json.RawMessage(`"10"`)}, "11", true},
+		{"int in bounds", ConfigOption{Type: "int", Min: json.RawMessage(`1`), Max: json.RawMessage(`10`)}, "10", false},
+		{"int empty bounds", ConfigOption{Type: "int", Min: json.RawMessage(`""`), Max: json.RawMessage(`""`)}, "100", false},
+		{"float", ConfigOption{Type: "float"}, "0.75", false},
+		{"float above max", ConfigOption{Type: "float", Max: json.RawMessage(`1`)}, "1.5", true},
+		{"bool", ConfigOption{Type: "bool"}, "true", false},
+		{"bool number", ConfigOption{Type: "bool"}, "1", false},
+		{"bool text", ConfigOption{Type: "bool"}, "maybe", true},
+		{"size", ConfigOption{Type: "size"}, "4G", false},
+		{"size bytes", ConfigOption{Type: "size"}, "4294967296", false},
+		{"size iec", ConfigOption{Type: "size"}, "512MiB", false},
+		{"size invalid", ConfigOption{Type: "size"}, "four gigs", true},
+		{"secs", ConfigOption{Type: "secs"}, "600", false},
+		{"secs unit", ConfigOption{Type: "secs"}, "10m", false},
+		{"secs invalid", ConfigOption{Type: "secs"}, "soon", true},
+		{"uuid", ConfigOption{Type: "uuid"}, "0b7e3ec4-7a4e-11ee-9b1a-525400123456", false},
+		{"uuid invalid", ConfigOption{Type: "uuid"}, "not-a-uuid", true},
+		{"str", ConfigOption{Type: "str"}, "anything", false},
+		{"enum", ConfigOption{Type: "str", EnumValues: []string{"none", "lz4", "snappy"}}, "lz4", false},
+		{"enum invalid", ConfigOption{Type: "str", EnumValues: []string{"none", "lz4", "snappy"}}, "zip", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.option.Name = "test_option"
+			err := ValidateConfigValue(&tt.option, tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfigValue(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// CrushNode represents a node of the CRUSH hierarchy as reported by "osd tree".
+// Buckets have negative IDs and children, OSDs have non-negative IDs.
+type CrushNode struct {
+	ID              int     `json:"id"`
+	Name            string  `json:"name"`
+	Type            string  `json:"type"`
+	TypeID          int     `json:"type_id"`
+	Children        []int   `json:"children,omitempty"`
+	DeviceClass     string  `json:"device_class,omitempty"`
+	CrushWeight     float64 `json:"crush_weight,omitempty"`
+	Status          string  `json:"status,omitempty"`
+	Reweight        float64 `json:"reweight,omitempty"`
+	PrimaryAffinity float64 `json:"primary_affinity,omitempty"`
+}
+
+// IsBucket reports whether the node is a CRUSH bucket rather than an OSD
+func (n CrushNode) IsBucket() bool {
+	return n.ID < 0
+}
+
+// GetCrushTree retrieves the nodes of the CRUSH hierarchy. OSDs come from the
+// OSD list, which only reports their host; the buckets are read from the OSD
+// map tree of the full health report.
+func (c *Client) GetCrushTree() ([]CrushNode, error) {
+	resp, err := c.DoRequest("GET", "/api/health/full", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var health struct {
+		OsdMap struct {
+			Tree struct {
+				Nodes []CrushNode `json:"nodes"`
+			} `json:"tree"`
+		} `json:"osd_map"`
+	}
+	err = json.Unmarshal(resp, &health)
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []CrushNode
+	for _, n := range health.OsdMap.Tree.Nodes {
+		if n.IsBucket() {
+			nodes = append(nodes, n)
+		}
+	}
+
+	osds, err := c.ListOsds()
+	if err != nil {
+		return nil, err
+	}
+	for _, osd := range osds {
+		// OSDs that are not part of the CRUSH map have no tree node
+		if osd.Tree.Name != "" {
+			nodes = append(nodes, osd.Tree)
+		}
+	}
+
+	return nodes, nil
+}
+
+// GetCrushNode retrieves a node of the CRUSH hierarchy by name
+func (c *Client) GetCrushNode(name string) (*CrushNode, error) {
+	nodes, err := c.GetCrushTree()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, n := range nodes {
+		if n.Name == name {
+			return &nodes[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("crush node %s %w", name, ErrNotFound)
+}
+
+// CrushAncestors returns the buckets above a node, from its parent up to the root
+func CrushAncestors(nodes []CrushNode, id int) []CrushNode {
+	parents := map[int]CrushNode{}
+	for _, n := range nodes {
+		for _, child := range n.Children {
+			parents[child] = n
+		}
+	}
+
+	var ancestors []CrushNode
+	for {
+		parent, ok := parents[id]
+		if !ok || len(ancestors) > len(nodes) {
+			return ancestors
+		}
+		ancestors = append(ancestors, parent)
+		id = parent.ID
+	}
+}
+
+// FormatCrushLocation builds a crush_location from bucket names keyed by type,
+// e.g. "host=node1 rack=rack1 root=default"
+func FormatCrushLocation(location map[string]string) string {
+	keys := make([]string, 0, len(location))
+	for k := range location {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, k+"="+location[k])
+	}
+	return strings.Join(parts, " ")
+}
+
+// ParseCrushLocation splits a crush_location into bucket names keyed by type
+func ParseCrushLocation(value string) (map[string]string, error) {
+	location := map[string]string{}
+	for _, part := range strings.Fields(value) {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok || k == "" || v == "" {
+			return nil, fmt.Errorf("invalid crush location %q", value)
+		}
+		location[k] = v
+	}
+	return location, nil
+}
@@ -0,0 +1,48 @@
+package client
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCrushAncestors(t *testing.T) {
+	nodes := []CrushNode{
+		{ID: -1, Name: "default", Type: "root", Children: []int{-3}},
+		{ID: -3, Name: "rack1", Type: "rack", Children: []int{-2}},
+		{ID: -2, Name: "node1", Type: "host", Children: []int{0}},
+		{ID: 0, Name: "osd.0", Type: "osd"},
+		{ID: -4, Name: "node2", Type: "host"},
+	}
+
+	var names []string
+	for _, n := range CrushAncestors(nodes, 0) {
+		names = append(names, n.Name)
+	}
+	if want := []string{"node1", "rack1", "default"}; !reflect.DeepEqual(names, want) {
+		t.Errorf("CrushAncestors(osd.0) = %v, want %v", names, want)
+	}
+
+	if got := CrushAncestors(nodes, -4); len(got) != 0 {
+		t.Errorf("CrushAncestors(node2) = %v, want none", got)
+	}
+}
+
+func TestCrushLocation(t *testing.T) {
+	location := map[string]string{"root": "default", "rack": "rack1", "host": "node1"}
+	value := FormatCrushLocation(location)
+	if want := "host=node1 rack=rack1 root=default"; value != want {
+		t.Errorf("FormatCrushLocation() = %q, want %q", value, want)
+	}
+
+	parsed, err := ParseCrushLocation(value)
+	if err != nil {
+		t.Fatalf("ParseCrushLocation(%q) failed: %s", value, err)
+	}
+	if !reflect.DeepEqual(parsed, location) {
+		t.Errorf("ParseCrushLocation(%q) = %v, want %v", value, parsed, location)
+	}
+
+	if _, err := ParseCrushLocation("host=node1 rack"); err == nil {
+		t.Errorf("ParseCrushLocation() of an incomplete location should fail")
+	}
+}
@@ -4,9 +4,17 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"strings"
 )
 
-// CrushRule represents a CRUSH rule
+// CRUSH rule types as reported by the CRUSH map
+const (
+	CrushRuleTypeReplicated = 1
+	CrushRuleTypeErasure    = 3
+)
+
+// CrushRule represents a replicated CRUSH rule to create. The API only
+// creates simple rules described by Root, FailureDomain and DeviceClass.
 type CrushRule struct {
 	Name          string `json:"name"`
 	Root          string `json:"root"`
@@ -14,18 +22,49 @@ type CrushRule struct {
 	DeviceClass   string `json:"device_class,omitempty"`
 }
 
+// CrushRuleStep represents a single step of a CRUSH rule
+type CrushRuleStep struct {
+	Op       string `json:"op"`
+	Item     int    `json:"item,omitempty"`
+	ItemName string `json:"item_name,omitempty"`
+	Num      int    `json:"num,omitempty"`
+	Type     string `json:"type,omitempty"`
+}
+
 // CrushRuleResponse represents the API response for a CRUSH rule
 type CrushRuleResponse struct {
-	RuleID   int    `json:"rule_id"`
-	RuleName string `json:"rule_name"`
-	Type     int    `json:"type"`
-	Steps    []struct {
-		Op       string `json:"op"`
-		Item     int    `json:"item,omitempty"`
-		ItemName string `json:"item_name,omitempty"`
-		Num      int    `json:"num,omitempty"`
-		Type     string `json:"type,omitempty"`
-	} `json:"steps"`
+	RuleID   int             `json:"rule_id"`
+	RuleName string          `json:"rule_name"`
+	Type     int             `json:"type"`
+	Steps    []CrushRuleStep `json:"steps"`
+}
+
+// TypeName returns the rule type as a string (replicated or erasure)
+func (r *CrushRuleResponse) TypeName() string {
+	switch r.Type {
+	case CrushRuleTypeReplicated:
+		return "replicated"
+	case CrushRuleTypeErasure:
+		return "erasure"
+	default:
+		return fmt.Sprintf("unknown(%d)", r.Type)
+	}
+}
+
+// Placement derives the root, failure domain and device class from the rule steps.
+// The root and device class come from the first take step, whose item name has the
+// form "root" or "root~class" when the rule is restricted to a device class.
+// The failure domain is the bucket type of the first choose/chooseleaf step.
+func (r *CrushRuleResponse) Placement() (root, failureDomain, deviceClass string) {
+	for _, step := range r.Steps {
+		switch {
+		case step.Op == "take" && root == "":
+			root, deviceClass, _ = strings.Cut(step.ItemName, "~")
+		case strings.HasPrefix(step.Op, "choose") && failureDomain == "":
+			failureDomain = step.Type
+		}
+	}
+	return root, failureDomain, deviceClass
 }
 
 // CreateCrushRule creates a new CRUSH rule
@@ -39,8 +78,8 @@ func (c *Client) CreateCrushRule(rule CrushRule) error {
 	return err
 }
 
-// GetCrushRule retrieves a CRUSH rule by name
-func (c *Client) GetCrushRule(name string) (*CrushRuleResponse, error) {
+// ListCrushRules retrieves all CRUSH rules
+func (c *Client) ListCrushRules() ([]CrushRuleResponse, error) {
 	resp, err := c.DoRequest("GET", "/api/crush_rule", nil)
 	if err != nil {
 		return nil, err
@@ -52,13 +91,23 @@ func (c *Client) GetCrushRule(name string) (*CrushRuleResponse, error) {
 		return nil, err
 	}
 
+	return rules, nil
+}
+
+// GetCrushRule retrieves a CRUSH rule by name
+func (c *Client) GetCrushRule(name string) (*CrushRuleResponse, error) {
+	rules, err := c.ListCrushRules()
+	if err != nil {
+		return nil, err
+	}
+
 	for _, r := range rules {
 		if r.RuleName == name {
 			return &r, nil
 		}
 	}
 
-	return nil, fmt.Errorf("crush rule %s not found", name)
+	return nil, fmt.Errorf("crush rule %s %w", name, ErrNotFound)
 }
 
 // DeleteCrushRule deletes a CRUSH rule by name
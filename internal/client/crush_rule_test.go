@@ -0,0 +1,67 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestCrushRulePlacement(t *testing.T) {
+	cases := []struct {
+		name              string
+		rule              CrushRuleResponse
+		wantType          string
+		wantRoot          string
+		wantFailureDomain string
+		wantDeviceClass   string
+	}{
+		{
+			name: "replicated rule with device class",
+			rule: CrushRuleResponse{
+				Type: CrushRuleTypeReplicated,
+				Steps: []CrushRuleStep{
+					{Op: "take", Item: -2, ItemName: "default~ssd"},
+					{Op: "chooseleaf_firstn", Num: 0, Type: "host"},
+					{Op: "emit"},
+				},
+			},
+			wantType:          "replicated",
+			wantRoot:          "default",
+			wantFailureDomain: "host",
+			wantDeviceClass:   "ssd",
+		},
+		{
+			name: "erasure stretch rule",
+			rule: CrushRuleResponse{
+				Type: CrushRuleTypeErasure,
+				Steps: []CrushRuleStep{
+					{Op: "set_chooseleaf_tries", Num: 5},
+					{Op: "set_choose_tries", Num: 100},
+					{Op: "take", Item: -1, ItemName: "default"},
+					{Op: "choose_indep", Num: 3, Type: "rack"},
+					{Op: "chooseleaf_indep", Num: 2, Type: "host"},
+					{Op: "emit"},
+				},
+			},
+			wantType:          "erasure",
+			wantRoot:          "default",
+			wantFailureDomain: "rack",
+		},
+		{
+			name:     "unknown type without steps",
+			rule:     CrushRuleResponse{Type: 2},
+			wantType: "unknown(2)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.rule.TypeName(); got != tc.wantType {
+				t.Errorf("TypeName() = %q, want %q", got, tc.wantType)
+			}
+			root, failureDomain, deviceClass := tc.rule.Placement()
+			if root != tc.wantRoot || failureDomain != tc.wantFailureDomain || deviceClass != tc.wantDeviceClass {
+				t.Errorf("Placement() = %q, %q, %q, want %q, %q, %q",
+					root, failureDomain, deviceClass, tc.wantRoot, tc.wantFailureDomain, tc.wantDeviceClass)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// DashboardPermissions lists the permissions a dashboard role can grant on a scope
+var DashboardPermissions = []string{"read", "create", "update", "delete"}
+
+// DashboardUser represents a dashboard user account
+type DashboardUser struct {
+	Username string   `json:"username"`
+	Name     string   `json:"name"`
+	Email    string   `json:"email"`
+	Roles    []string `json:"roles"`
+	Enabled  bool     `json:"enabled"`
+	// PwdExpirationDate is a Unix timestamp in seconds, nil when the password does not expire
+	PwdExpirationDate *int64 `json:"pwdExpirationDate"`
+	PwdUpdateRequired bool   `json:"pwdUpdateRequired"`
+}
+
+// DashboardUserRequest represents the payload for creating/updating a dashboard user
+type DashboardUserRequest struct {
+	DashboardUser
+	// Password is only sent when set
+	Password string `json:"password,omitempty"`
+}
+
+// DashboardRole represents a dashboard role, granting permissions per scope
+// (e.g. pool: [read, update])
+type DashboardRole struct {
+	Name              string              `json:"name"`
+	Description       string              `json:"description"`
+	ScopesPermissions map[string][]string `json:"scopes_permissions"`
+	System            bool                `json:"system,omitempty"`
+}
+
+// DashboardPasswordCheck represents the result of a password policy check
+type DashboardPasswordCheck struct {
+	Valid     bool   `json:"valid"`
+	Credits   int    `json:"credits"`
+	Valuation string `json:"valuation"`
+}
+
+// GetDashboardUser retrieves a dashboard user by username
+func (c *Client) GetDashboardUser(username string) (*DashboardUser, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/user/%s", url.PathEscape(username)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var user DashboardUser
+	err = json.Unmarshal(resp, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// CreateDashboardUser creates a new dashboard user
+func (c *Client) CreateDashboardUser(user DashboardUserRequest) error {
+	rb, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/user", bytes.NewBuffer(rb))
+	return err
+}
+
+// UpdateDashboardUser updates an existing dashboard user; the password is
+// only changed when set
+func (c *Client) UpdateDashboardUser(user DashboardUserRequest) error {
+	rb, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/user/%s", url.PathEscape(user.Username)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteDashboardUser deletes a dashboard user
+func (c *Client) DeleteDashboardUser(username string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/user/%s", url.PathEscape(username)), nil)
+	return err
+}
+
+// ValidateDashboardPassword checks a password against the dashboard password policy
+func (c *Client) ValidateDashboardPassword(username, password string) (*DashboardPasswordCheck, error) {
+	payload := map[string]string{
+		"username": username,
+		"password": password,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.DoRequest("POST", "/api/user/validate_password", bytes.NewBuffer(rb))
+	if err != nil {
+		return nil, err
+	}
+
+	var check DashboardPasswordCheck
+	err = json.Unmarshal(resp, &check)
+	if err != nil {
+		return nil, err
+	}
+
+	return &check, nil
+}
+
+// GetDashboardRole retrieves a dashboard role by name
+func (c *Client) GetDashboardRole(name string) (*DashboardRole, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/role/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var role DashboardRole
+	err = json.Unmarshal(resp, &role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+// CreateDashboardRole creates a new dashboard role
+func (c *Client) CreateDashboardRole(role DashboardRole) error {
+	role.System = false
+	rb, err := json.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/role", bytes.NewBuffer(rb))
+	return err
+}
+
+// UpdateDashboardRole updates an existing dashboard role
+func (c *Client) UpdateDashboardRole(role DashboardRole) error {
+	role.System = false
+	rb, err := json.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/role/%s", url.PathEscape(role.Name)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteDashboardRole deletes a dashboard role
+func (c *Client) DeleteDashboardRole(name string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/role/%s", url.PathEscape(name)), nil)
+	return err
+}
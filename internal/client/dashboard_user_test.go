@@ -0,0 +1,37 @@
+package client
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDashboardUserRequestMarshal(t *testing.T) {
+	expiration := int64(1767225600)
+	req := DashboardUserRequest{
+		DashboardUser: DashboardUser{
+			Username:          "alice",
+			Roles:             []string{"read-only"},
+			Enabled:           true,
+			PwdExpirationDate: &expiration,
+		},
+	}
+
+	rb, err := json.Marshal(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	s := string(rb)
+	if strings.Contains(s, `"password"`) {
+		t.Errorf("expected no password when unset, got %s", s)
+	}
+	if !strings.Contains(s, `"pwdExpirationDate":1767225600`) || !strings.Contains(s, `"username":"alice"`) {
+		t.Errorf("unexpected payload: %s", s)
+	}
+
+	req.Password = "s3cret-Passw0rd"
+	rb, _ = json.Marshal(req)
+	if !strings.Contains(string(rb), `"password":"s3cret-Passw0rd"`) {
+		t.Errorf("expected the password, got %s", rb)
+	}
+}
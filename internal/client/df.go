@@ -0,0 +1,38 @@
+package client
+
+import "sort"
+
+// DeviceClassUsage represents the raw capacity of the OSDs of a device class
+type DeviceClassUsage struct {
+	DeviceClass string
+	TotalBytes  int64
+	UsedBytes   int64
+	AvailBytes  int64
+	Osds        int
+}
+
+// UsageByDeviceClass sums the raw capacity of the OSDs per device class, sorted by class.
+// OSDs without a device class are reported under an empty class.
+func UsageByDeviceClass(osds []OsdSummary) []DeviceClassUsage {
+	byClass := map[string]*DeviceClassUsage{}
+	for _, osd := range osds {
+		usage, ok := byClass[osd.Tree.DeviceClass]
+		if !ok {
+			usage = &DeviceClassUsage{DeviceClass: osd.Tree.DeviceClass}
+			byClass[osd.Tree.DeviceClass] = usage
+		}
+		usage.TotalBytes += int64(osd.Stats.StatBytes)
+		usage.UsedBytes += int64(osd.Stats.StatBytesUsed)
+		usage.Osds++
+	}
+
+	classes := make([]DeviceClassUsage, 0, len(byClass))
+	for _, usage := range byClass {
+		usage.AvailBytes = max(usage.TotalBytes-usage.UsedBytes, 0)
+		classes = append(classes, *usage)
+	}
+	sort.Slice(classes, func(i, j int) bool {
+		return classes[i].DeviceClass < classes[j].DeviceClass
+	})
+	return classes
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+const osdList = `[
+	{"osd": 0, "tree": {"device_class": "ssd"}, "stats": {"stat_bytes": 1000, "stat_bytes_used": 100}},
+	{"osd": 1, "tree": {"device_class": "hdd"}, "stats": {"stat_bytes": 4000, "stat_bytes_used": 1000}},
+	{"osd": 2, "tree": {"device_class": "ssd"}, "stats": {"stat_bytes": 1000, "stat_bytes_used": 300}},
+	{"osd": 3, "tree": {}, "stats": {}}
+]`
+
+func TestUsageByDeviceClass(t *testing.T) {
+	var osds []OsdSummary
+	if err := json.Unmarshal([]byte(osdList), &osds); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got := UsageByDeviceClass(osds)
+	want := []DeviceClassUsage{
+		{DeviceClass: "", Osds: 1},
+		{DeviceClass: "hdd", TotalBytes: 4000, UsedBytes: 1000, AvailBytes: 3000, Osds: 1},
+		{DeviceClass: "ssd", TotalBytes: 2000, UsedBytes: 400, AvailBytes: 1600, Osds: 2},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if got := UsageByDeviceClass(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list, got %v", got)
+	}
+}
+
+func TestPoolQuotaRatio(t *testing.T) {
+	const poolStats = `{
+		"pool_name": "rbd",
+		"quota_max_bytes": 1000,
+		"quota_max_objects": 0,
+		"stats": {
+			"stored": {"latest": 250, "rate": 0.5, "rates": []},
+			"bytes_used": {"latest": 750},
+			"objects": {"latest": 12},
+			"max_avail": {"latest": 5000},
+			"percent_used": {"latest": 0.15}
+		}
+	}`
+	var resp poolResponse
+	if err := json.Unmarshal([]byte(poolStats), &resp); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	pool := resp.pool()
+
+	if pool.Stats.BytesUsed.Latest != 750 || pool.Stats.MaxAvail.Latest != 5000 || pool.Stats.PercentUsed.Latest != 0.15 {
+		t.Errorf("unexpected stats: %+v", pool.Stats)
+	}
+	if ratio, ok := pool.QuotaBytesRatio(); !ok || ratio != 0.25 {
+		t.Errorf("QuotaBytesRatio() = %v, %v, want 0.25, true", ratio, ok)
+	}
+	if _, ok := pool.QuotaObjectsRatio(); ok {
+		t.Error("QuotaObjectsRatio() reported a ratio without an object quota")
+	}
+
+	pool.QuotaMaxObjects = 48
+	if ratio, ok := pool.QuotaObjectsRatio(); !ok || ratio != 0.25 {
+		t.Errorf("QuotaObjectsRatio() = %v, %v, want 0.25, true", ratio, ok)
+	}
+}
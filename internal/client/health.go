@@ -0,0 +1,164 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// HealthOK is the overall status of a healthy cluster
+const HealthOK = "HEALTH_OK"
+
+// HealthCheck represents a raised health check (e.g. OSDMAP_FLAGS)
+type HealthCheck struct {
+	Type     string `json:"type"`
+	Severity string `json:"severity"`
+	Summary  struct {
+		Message string `json:"message"`
+		Count   int    `json:"count"`
+	} `json:"summary"`
+	Muted bool `json:"muted"`
+}
+
+// HealthChecks is a list of health checks. Depending on the endpoint, Ceph
+// reports the checks as a list or as a map keyed by check type.
+type HealthChecks []HealthCheck
+
+func (h *HealthChecks) UnmarshalJSON(data []byte) error {
+	var list []HealthCheck
+	if err := json.Unmarshal(data, &list); err == nil {
+		*h = list
+		return nil
+	}
+
+	var byType map[string]HealthCheck
+	if err := json.Unmarshal(data, &byType); err != nil {
+		return fmt.Errorf("invalid health checks: %s", string(data))
+	}
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	*h = make([]HealthCheck, 0, len(byType))
+	for _, t := range types {
+		check := byType[t]
+		check.Type = t
+		*h = append(*h, check)
+	}
+	return nil
+}
+
+// Health represents the health and status summary of the cluster
+type Health struct {
+	Health struct {
+		Status string       `json:"status"`
+		Checks HealthChecks `json:"checks"`
+	} `json:"health"`
+	MonStatus struct {
+		MonMap struct {
+			Mons []Monitor `json:"mons"`
+		} `json:"monmap"`
+		Quorum []int `json:"quorum"`
+	} `json:"mon_status"`
+	OsdMap struct {
+		Osds []struct {
+			Up int `json:"up"`
+			In int `json:"in"`
+		} `json:"osds"`
+	} `json:"osd_map"`
+	PgInfo struct {
+		Statuses map[string]int `json:"statuses"`
+	} `json:"pg_info"`
+	Df struct {
+		Stats struct {
+			TotalBytes        int64 `json:"total_bytes"`
+			TotalAvailBytes   int64 `json:"total_avail_bytes"`
+			TotalUsedRawBytes int64 `json:"total_used_raw_bytes"`
+		} `json:"stats"`
+	} `json:"df"`
+}
+
+// QuorumNames returns the names of the monitors in quorum
+func (h *Health) QuorumNames() []string {
+	names := []string{}
+	for _, mon := range h.MonStatus.MonMap.Mons {
+		if slices.Contains(h.MonStatus.Quorum, mon.Rank) {
+			names = append(names, mon.Name)
+		}
+	}
+	return names
+}
+
+// BlockingChecks returns the health checks that are neither muted nor allowed.
+// A cluster reporting HEALTH_OK has no blocking checks.
+func (h *Health) BlockingChecks(allowed []string) []HealthCheck {
+	blocking := []HealthCheck{}
+	if h.Health.Status == HealthOK {
+		return blocking
+	}
+	for _, check := range h.Health.Checks {
+		if !check.Muted && !slices.Contains(allowed, check.Type) {
+			blocking = append(blocking, check)
+		}
+	}
+	return blocking
+}
+
+// GetHealth retrieves the health and status summary of the cluster
+func (c *Client) GetHealth() (*Health, error) {
+	resp, err := c.DoRequest("GET", "/api/health/minimal", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var health Health
+	err = json.Unmarshal(resp, &health)
+	if err != nil {
+		return nil, err
+	}
+
+	return &health, nil
+}
+
+// HealthGate refuses changes to an unhealthy cluster. The health is checked
+// once, before the first mutating request of the client.
+type HealthGate struct {
+	// AllowedChecks lists the health checks that do not block changes (e.g. OSDMAP_FLAGS)
+	AllowedChecks []string
+
+	once sync.Once
+	err  error
+}
+
+// healthGateExemptEndpoints lists the non-GET endpoints that do not change the cluster.
+// They are used when reading state, which must keep working on an unhealthy cluster.
+var healthGateExemptEndpoints = []string{
+	"/api/user/validate_password",
+	"/api/cluster/user/export",
+}
+
+func (g *HealthGate) check(c *Client) error {
+	g.once.Do(func() {
+		health, err := c.GetHealth()
+		if err != nil {
+			g.err = fmt.Errorf("unable to check cluster health: %w", err)
+			return
+		}
+
+		blocking := health.BlockingChecks(g.AllowedChecks)
+		if len(blocking) == 0 {
+			return
+		}
+		messages := make([]string, 0, len(blocking))
+		for _, check := range blocking {
+			messages = append(messages, fmt.Sprintf("%s (%s): %s", check.Type, check.Severity, check.Summary.Message))
+		}
+		g.err = fmt.Errorf("refusing to change the cluster because its health is %s: %s",
+			health.Health.Status, strings.Join(messages, "; "))
+	})
+	return g.err
+}
@@ -0,0 +1,121 @@
+package client
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const healthMinimal = `{
+	"health": {
+		"status": "HEALTH_WARN",
+		"checks": [
+			{"type": "OSDMAP_FLAGS", "severity": "HEALTH_WARN", "summary": {"message": "noout flag(s) set", "count": 1}, "muted": false},
+			{"type": "RECENT_CRASH", "severity": "HEALTH_WARN", "summary": {"message": "1 daemons have recently crashed", "count": 1}, "muted": true}
+		]
+	},
+	"mon_status": {
+		"monmap": {"mons": [{"name": "a", "rank": 0}, {"name": "b", "rank": 1}, {"name": "c", "rank": 2}]},
+		"quorum": [0, 2]
+	},
+	"osd_map": {"osds": [{"up": 1, "in": 1}, {"up": 0, "in": 1}, {"up": 0, "in": 0}]},
+	"pg_info": {"statuses": {"active+clean": 120, "active+undersized": 8}},
+	"df": {"stats": {"total_bytes": 3000, "total_avail_bytes": 2000, "total_used_raw_bytes": 1000}}
+}`
+
+func TestHealthUnmarshal(t *testing.T) {
+	var health Health
+	if err := json.Unmarshal([]byte(healthMinimal), &health); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if health.Health.Status != "HEALTH_WARN" || len(health.Health.Checks) != 2 {
+		t.Errorf("unexpected health: %+v", health.Health)
+	}
+	if got := health.QuorumNames(); !slices.Equal(got, []string{"a", "c"}) {
+		t.Errorf("quorum names = %v, want [a c]", got)
+	}
+	if len(health.OsdMap.Osds) != 3 || health.PgInfo.Statuses["active+undersized"] != 8 {
+		t.Errorf("unexpected osd map or pg info: %+v %+v", health.OsdMap, health.PgInfo)
+	}
+	if health.Df.Stats.TotalAvailBytes != 2000 {
+		t.Errorf("unexpected df: %+v", health.Df)
+	}
+}
+
+func TestHealthChecksUnmarshalMap(t *testing.T) {
+	input := `{
+		"SLOW_OPS": {"severity": "HEALTH_WARN", "summary": {"message": "slow ops", "count": 3}},
+		"MON_DOWN": {"severity": "HEALTH_WARN", "summary": {"message": "1/3 mons down", "count": 1}}
+	}`
+
+	var checks HealthChecks
+	if err := json.Unmarshal([]byte(input), &checks); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(checks) != 2 || checks[0].Type != "MON_DOWN" || checks[1].Type != "SLOW_OPS" || checks[1].Summary.Count != 3 {
+		t.Errorf("unexpected checks: %+v", checks)
+	}
+
+	if err := json.Unmarshal([]byte(`"HEALTH_OK"`), &checks); err == nil {
+		t.Error("expected an error for invalid checks")
+	}
+}
+
+func TestHealthBlockingChecks(t *testing.T) {
+	var health Health
+	if err := json.Unmarshal([]byte(healthMinimal), &health); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	blocking := health.BlockingChecks(nil)
+	if len(blocking) != 1 || blocking[0].Type != "OSDMAP_FLAGS" {
+		t.Errorf("expected OSDMAP_FLAGS to block (RECENT_CRASH is muted), got %+v", blocking)
+	}
+
+	if blocking := health.BlockingChecks([]string{"OSDMAP_FLAGS"}); len(blocking) != 0 {
+		t.Errorf("expected no blocking checks when allowed, got %+v", blocking)
+	}
+
+	health.Health.Status = HealthOK
+	if blocking := health.BlockingChecks(nil); len(blocking) != 0 {
+		t.Errorf("expected no blocking checks for HEALTH_OK, got %+v", blocking)
+	}
+}
+
+func TestHealthGateAllowsReads(t *testing.T) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/health/minimal":
+			w.Write([]byte(`{"health": {"status": "HEALTH_ERR", "checks": [{"type": "MON_DOWN", "severity": "HEALTH_ERR", "summary": {"message": "1/3 mons down", "count": 1}}]}}`))
+		case "/api/cluster/user/export":
+			w.Write([]byte(`"[client.app]\n\tkey = AQBtZXN0a2V5PT0=\n"`))
+		default:
+			w.WriteHeader(http.StatusCreated)
+		}
+	}))
+	defer server.Close()
+
+	c := &Client{HTTPClient: server.Client(), HostURL: server.URL, HealthGate: &HealthGate{}}
+
+	key, err := c.ExportUser("client.app")
+	if err != nil {
+		t.Fatalf("expected the export to bypass the health gate, got: %s", err)
+	}
+	if key != "AQBtZXN0a2V5PT0=" {
+		t.Errorf("key = %q, want AQBtZXN0a2V5PT0=", key)
+	}
+
+	err = c.CreatePool(Pool{PoolName: "rbd"})
+	if err == nil || !strings.Contains(err.Error(), "MON_DOWN") {
+		t.Errorf("expected the health gate to refuse the change, got: %v", err)
+	}
+	if slices.Contains(requested, "/api/pool") {
+		t.Error("expected the pool creation not to be sent")
+	}
+}
@@ -0,0 +1,184 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// HostStatusMaintenance is the status of a host in maintenance mode
+const HostStatusMaintenance = "maintenance"
+
+// HostService represents a service with daemons on a host
+type HostService struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Host represents a host known to the orchestrator
+type Host struct {
+	Hostname    string        `json:"hostname"`
+	Addr        string        `json:"addr"`
+	Labels      []string      `json:"labels"`
+	Status      string        `json:"status"`
+	CephVersion string        `json:"ceph_version"`
+	Services    []HostService `json:"services"`
+}
+
+// HostDevice represents a storage device in the inventory of a host
+type HostDevice struct {
+	Path            string   `json:"path"`
+	Type            string   `json:"human_readable_type"`
+	Available       bool     `json:"available"`
+	RejectedReasons []string `json:"rejected_reasons"`
+	SysAPI          struct {
+		Size float64 `json:"size"`
+	} `json:"sys_api"`
+}
+
+// HostDaemon represents a daemon deployed on a host
+type HostDaemon struct {
+	DaemonType string `json:"daemon_type"`
+	DaemonID   string `json:"daemon_id"`
+	Status     int    `json:"status"`
+}
+
+// ListHosts retrieves all hosts known to the orchestrator
+func (c *Client) ListHosts() ([]Host, error) {
+	resp, err := c.DoRequest("GET", "/api/host?sources=orchestrator", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []Host
+	err = json.Unmarshal(resp, &hosts)
+	if err != nil {
+		return nil, err
+	}
+
+	return hosts, nil
+}
+
+// GetHost retrieves a host by name
+func (c *Client) GetHost(hostname string) (*Host, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/host/%s", url.PathEscape(hostname)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var host Host
+	err = json.Unmarshal(resp, &host)
+	if err != nil {
+		return nil, err
+	}
+	if host.Hostname == "" {
+		return nil, fmt.Errorf("host %s %w", hostname, ErrNotFound)
+	}
+
+	return &host, nil
+}
+
+// CreateHost adds a host to the orchestrator (ceph orch host add)
+func (c *Client) CreateHost(hostname, addr string, labels []string, maintenance bool) error {
+	payload := map[string]interface{}{
+		"hostname": hostname,
+		"labels":   labels,
+	}
+	if addr != "" {
+		payload["addr"] = addr
+	}
+	if maintenance {
+		payload["status"] = HostStatusMaintenance
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/host", bytes.NewBuffer(rb))
+	return err
+}
+
+func (c *Client) updateHost(hostname string, payload map[string]interface{}) error {
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/host/%s", url.PathEscape(hostname)), bytes.NewBuffer(rb))
+	return err
+}
+
+// SetHostLabels replaces the labels of a host
+func (c *Client) SetHostLabels(hostname string, labels []string) error {
+	return c.updateHost(hostname, map[string]interface{}{
+		"update_labels": true,
+		"labels":        labels,
+	})
+}
+
+// SetHostMaintenance enters or exits the maintenance mode of a host. The API
+// toggles the mode, so nothing is sent when the host is already in the
+// requested mode.
+func (c *Client) SetHostMaintenance(hostname string, maintenance bool) error {
+	host, err := c.GetHost(hostname)
+	if err != nil {
+		return err
+	}
+	if (host.Status == HostStatusMaintenance) == maintenance {
+		return nil
+	}
+
+	return c.updateHost(hostname, map[string]interface{}{
+		"maintenance": true,
+		"force":       false,
+	})
+}
+
+// DrainHost schedules the removal of all daemons of a host (ceph orch host drain)
+func (c *Client) DrainHost(hostname string) error {
+	return c.updateHost(hostname, map[string]interface{}{
+		"drain": true,
+	})
+}
+
+// ListHostDaemons retrieves the daemons deployed on a host
+func (c *Client) ListHostDaemons(hostname string) ([]HostDaemon, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/host/%s/daemons", url.PathEscape(hostname)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var daemons []HostDaemon
+	err = json.Unmarshal(resp, &daemons)
+	if err != nil {
+		return nil, err
+	}
+
+	return daemons, nil
+}
+
+// GetHostInventory retrieves the storage devices of a host
+func (c *Client) GetHostInventory(hostname string) ([]HostDevice, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/host/%s/inventory", url.PathEscape(hostname)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var inventory struct {
+		Devices []HostDevice `json:"devices"`
+	}
+	err = json.Unmarshal(resp, &inventory)
+	if err != nil {
+		return nil, err
+	}
+
+	return inventory.Devices, nil
+}
+
+// DeleteHost removes a host from the orchestrator (ceph orch host rm)
+func (c *Client) DeleteHost(hostname string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/host/%s", url.PathEscape(hostname)), nil)
+	return err
+}
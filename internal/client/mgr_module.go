@@ -0,0 +1,104 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// MgrModuleOption represents the definition of a manager module option
+type MgrModuleOption struct {
+	Name         string          `json:"name"`
+	Type         string          `json:"type"`
+	Level        string          `json:"level"`
+	DefaultValue json.RawMessage `json:"default_value"`
+	Desc         string          `json:"desc"`
+}
+
+// MgrModule represents a manager module
+type MgrModule struct {
+	Name     string                     `json:"name"`
+	Enabled  bool                       `json:"enabled"`
+	AlwaysOn bool                       `json:"always_on"`
+	Options  map[string]MgrModuleOption `json:"options"`
+}
+
+// ListMgrModules retrieves all manager modules
+func (c *Client) ListMgrModules() ([]MgrModule, error) {
+	resp, err := c.DoRequest("GET", "/api/mgr/module", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []MgrModule
+	err = json.Unmarshal(resp, &modules)
+	if err != nil {
+		return nil, err
+	}
+
+	return modules, nil
+}
+
+// GetMgrModule retrieves a manager module by name
+func (c *Client) GetMgrModule(name string) (*MgrModule, error) {
+	modules, err := c.ListMgrModules()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range modules {
+		if modules[i].Name == name {
+			return &modules[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("mgr module %s %w", name, ErrNotFound)
+}
+
+// GetMgrModuleConfig retrieves the current option values of a manager module
+func (c *Client) GetMgrModuleConfig(name string) (map[string]string, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/mgr/module/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	err = json.Unmarshal(resp, &raw)
+	if err != nil {
+		return nil, err
+	}
+
+	config := make(map[string]string, len(raw))
+	for k, v := range raw {
+		config[k] = RawString(v)
+	}
+
+	return config, nil
+}
+
+// SetMgrModuleConfig sets option values of a manager module
+func (c *Client) SetMgrModuleConfig(name string, config map[string]string) error {
+	payload := map[string]interface{}{
+		"config": config,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/mgr/module/%s", url.PathEscape(name)), bytes.NewBuffer(rb))
+	return err
+}
+
+// EnableMgrModule enables a manager module (ceph mgr module enable)
+func (c *Client) EnableMgrModule(name string) error {
+	_, err := c.DoRequest("POST", fmt.Sprintf("/api/mgr/module/%s/enable", url.PathEscape(name)), nil)
+	return err
+}
+
+// DisableMgrModule disables a manager module (ceph mgr module disable)
+func (c *Client) DisableMgrModule(name string) error {
+	_, err := c.DoRequest("POST", fmt.Sprintf("/api/mgr/module/%s/disable", url.PathEscape(name)), nil)
+	return err
+}
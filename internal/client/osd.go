@@ -0,0 +1,159 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+)
+
+// OsdMapEntry represents the state of an OSD in the OSD map
+type OsdMapEntry struct {
+	Osd   int      `json:"osd"`
+	UUID  string   `json:"uuid"`
+	Up    int      `json:"up"`
+	In    int      `json:"in"`
+	State []string `json:"state"`
+	// Weight is the reweight value (0 to 1) of the OSD
+	Weight float64 `json:"weight"`
+}
+
+// Destroyed reports whether the OSD was destroyed and its ID kept for a replacement
+func (e OsdMapEntry) Destroyed() bool {
+	return slices.Contains(e.State, "destroyed")
+}
+
+// OsdMetadata represents the metadata reported by an OSD daemon
+type OsdMetadata struct {
+	Hostname    string `json:"hostname"`
+	Devices     string `json:"devices"`
+	Objectstore string `json:"osd_objectstore"`
+}
+
+// Osd represents an OSD (for GET responses)
+type Osd struct {
+	OsdMap   OsdMapEntry `json:"osd_map"`
+	Metadata OsdMetadata `json:"osd_metadata"`
+}
+
+// OsdSummary represents an OSD of the OSD list with its device class and capacity
+type OsdSummary struct {
+	Osd   int       `json:"osd"`
+	Tree  CrushNode `json:"tree"`
+	Stats struct {
+		StatBytes     float64 `json:"stat_bytes"`
+		StatBytesUsed float64 `json:"stat_bytes_used"`
+	} `json:"stats"`
+}
+
+// OsdSafeToDestroy represents the result of a safe-to-destroy check
+type OsdSafeToDestroy struct {
+	IsSafeToDestroy bool   `json:"is_safe_to_destroy"`
+	Active          []int  `json:"active"`
+	MissingStats    []int  `json:"missing_stats"`
+	StoredPgs       []int  `json:"stored_pgs"`
+	SafeToDestroy   []int  `json:"safe_to_destroy"`
+	Message         string `json:"message"`
+}
+
+// GetOsd retrieves an OSD by ID
+func (c *Client) GetOsd(id int) (*Osd, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/osd/%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var osd Osd
+	err = json.Unmarshal(resp, &osd)
+	if err != nil {
+		return nil, err
+	}
+	if osd.OsdMap.UUID == "" {
+		return nil, fmt.Errorf("osd.%d %w", id, ErrNotFound)
+	}
+
+	return &osd, nil
+}
+
+// ListOsds retrieves all OSDs
+func (c *Client) ListOsds() ([]OsdSummary, error) {
+	resp, err := c.DoRequest("GET", "/api/osd", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var osds []OsdSummary
+	err = json.Unmarshal(resp, &osds)
+	if err != nil {
+		return nil, err
+	}
+
+	return osds, nil
+}
+
+// MarkOsd marks an OSD in, out, down or lost
+func (c *Client) MarkOsd(id int, action string) error {
+	payload := map[string]string{
+		"action": action,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/osd/%d/mark", id), bytes.NewBuffer(rb))
+	return err
+}
+
+// OsdSafeToDestroyCheck checks whether an OSD can be destroyed without reducing
+// data durability or availability
+func (c *Client) OsdSafeToDestroyCheck(id int) (*OsdSafeToDestroy, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/osd/safe_to_destroy?ids=%d", id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var result OsdSafeToDestroy
+	err = json.Unmarshal(resp, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// RemoveOsd removes an OSD through the orchestrator (ceph orch osd rm). With
+// replace, the OSD is only marked destroyed so that its ID is reused by the
+// OSD deployed on the replacement device.
+func (c *Client) RemoveOsd(id int, replace bool) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/osd/%d?preserve_id=%t&force=false", id, replace), nil)
+	return err
+}
+
+// ReweightOsd sets the reweight value (0 to 1) of an OSD
+func (c *Client) ReweightOsd(id int, weight float64) error {
+	payload := map[string]float64{
+		"weight": weight,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", fmt.Sprintf("/api/osd/%d/reweight", id), bytes.NewBuffer(rb))
+	return err
+}
+
+// SetOsdDeviceClass replaces the CRUSH device class of an OSD
+func (c *Client) SetOsdDeviceClass(id int, deviceClass string) error {
+	payload := map[string]string{
+		"device_class": deviceClass,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/osd/%d", id), bytes.NewBuffer(rb))
+	return err
+}
@@ -0,0 +1,117 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"slices"
+)
+
+// OsdSettableFlags lists the cluster-wide OSD flags that can be set and unset.
+// Other flags (e.g. sortbitwise, recovery_deletes) are permanent and left alone.
+var OsdSettableFlags = []string{
+	"noout", "noin", "nodown", "noup", "norebalance", "norecover", "nobackfill",
+	"noscrub", "nodeep-scrub", "notieragent", "nosnaptrim", "pause",
+}
+
+// OsdIndividualFlags lists the flags that can be set on individual OSDs
+var OsdIndividualFlags = []string{"noout", "noin", "nodown", "noup"}
+
+// OsdIndividualFlagsEntry represents the flags set on an individual OSD
+type OsdIndividualFlagsEntry struct {
+	Osd   int      `json:"osd"`
+	Flags []string `json:"flags"`
+}
+
+// SettableOsdFlags returns the settable flags among the given cluster flags
+func SettableOsdFlags(flags []string) []string {
+	result := []string{}
+	for _, flag := range flags {
+		if slices.Contains(OsdSettableFlags, flag) {
+			result = append(result, flag)
+		}
+	}
+	return result
+}
+
+// MergeOsdFlags returns the complete cluster flag list replacing the settable
+// flags among current by desired, keeping the permanent flags
+func MergeOsdFlags(current, desired []string) []string {
+	result := []string{}
+	for _, flag := range current {
+		if !slices.Contains(OsdSettableFlags, flag) {
+			result = append(result, flag)
+		}
+	}
+	for _, flag := range desired {
+		if !slices.Contains(result, flag) {
+			result = append(result, flag)
+		}
+	}
+	return result
+}
+
+// GetOsdFlags retrieves the cluster-wide OSD flags
+func (c *Client) GetOsdFlags() ([]string, error) {
+	resp, err := c.DoRequest("GET", "/api/osd/flags", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var flags []string
+	err = json.Unmarshal(resp, &flags)
+	if err != nil {
+		return nil, err
+	}
+
+	return flags, nil
+}
+
+// SetOsdFlags replaces the cluster-wide OSD flags; flags is the complete list
+func (c *Client) SetOsdFlags(flags []string) error {
+	payload := map[string][]string{
+		"flags": flags,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", "/api/osd/flags", bytes.NewBuffer(rb))
+	return err
+}
+
+// GetOsdIndividualFlags retrieves the flags set on an individual OSD
+func (c *Client) GetOsdIndividualFlags(id int) ([]string, error) {
+	resp, err := c.DoRequest("GET", "/api/osd/flags/individual", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []OsdIndividualFlagsEntry
+	err = json.Unmarshal(resp, &entries)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		if entry.Osd == id {
+			return entry.Flags, nil
+		}
+	}
+	return []string{}, nil
+}
+
+// SetOsdIndividualFlag sets or unsets a flag on an individual OSD
+func (c *Client) SetOsdIndividualFlag(id int, flag string, value bool) error {
+	payload := map[string]interface{}{
+		"flags": map[string]bool{flag: value},
+		"ids":   []int{id},
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", "/api/osd/flags/individual", bytes.NewBuffer(rb))
+	return err
+}
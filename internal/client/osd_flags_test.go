@@ -0,0 +1,61 @@
+package client
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSettableOsdFlags(t *testing.T) {
+	got := SettableOsdFlags([]string{"sortbitwise", "noout", "recovery_deletes", "norebalance", "purged_snapdirs"})
+	want := []string{"noout", "norebalance"}
+	if !slices.Equal(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := SettableOsdFlags(nil); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list, got %v", got)
+	}
+}
+
+func TestMergeOsdFlags(t *testing.T) {
+	tests := []struct {
+		name    string
+		current []string
+		desired []string
+		want    []string
+	}{
+		{
+			name:    "set flags",
+			current: []string{"sortbitwise", "recovery_deletes"},
+			desired: []string{"noout", "norebalance"},
+			want:    []string{"sortbitwise", "recovery_deletes", "noout", "norebalance"},
+		},
+		{
+			name:    "unset flags",
+			current: []string{"sortbitwise", "noout", "norebalance"},
+			desired: []string{},
+			want:    []string{"sortbitwise"},
+		},
+		{
+			name:    "replace flags",
+			current: []string{"noout", "sortbitwise", "noscrub"},
+			desired: []string{"noscrub", "nodeep-scrub"},
+			want:    []string{"sortbitwise", "noscrub", "nodeep-scrub"},
+		},
+		{
+			name:    "duplicate flags",
+			current: []string{"sortbitwise"},
+			desired: []string{"noout", "noout"},
+			want:    []string{"sortbitwise", "noout"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MergeOsdFlags(tt.current, tt.desired)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
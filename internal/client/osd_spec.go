@@ -0,0 +1,101 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// OsdDeviceFilter selects the devices of an OSD specification (drive group)
+type OsdDeviceFilter struct {
+	All        bool     `json:"all,omitempty"`
+	Paths      []string `json:"paths,omitempty"`
+	Rotational *bool    `json:"rotational,omitempty"`
+	Size       string   `json:"size,omitempty"`
+	Model      string   `json:"model,omitempty"`
+	Vendor     string   `json:"vendor,omitempty"`
+	Limit      int      `json:"limit,omitempty"`
+}
+
+// OsdSpecSettings represents the OSD specific settings of an OSD specification
+type OsdSpecSettings struct {
+	DataDevices   *OsdDeviceFilter `json:"data_devices,omitempty"`
+	DBDevices     *OsdDeviceFilter `json:"db_devices,omitempty"`
+	WALDevices    *OsdDeviceFilter `json:"wal_devices,omitempty"`
+	Encrypted     bool             `json:"encrypted,omitempty"`
+	OsdsPerDevice int              `json:"osds_per_device,omitempty"`
+}
+
+// OsdSpec represents an OSD service specification (drive group)
+type OsdSpec struct {
+	ServiceID string           `json:"service_id"`
+	Placement ServicePlacement `json:"placement"`
+	Unmanaged bool             `json:"unmanaged,omitempty"`
+	Spec      OsdSpecSettings  `json:"spec"`
+	// Status is only set in GET responses
+	Status ServiceStatus `json:"-"`
+}
+
+// OsdSpecServiceName returns the orchestrator name of an OSD specification
+func OsdSpecServiceName(serviceID string) string {
+	return ServiceName("osd", serviceID)
+}
+
+// osdSpecFromService converts a generic orchestrator service into an OSD specification
+func osdSpecFromService(service *Service) (*OsdSpec, error) {
+	spec := OsdSpec{
+		ServiceID: service.ServiceID,
+		Placement: service.Placement,
+		Unmanaged: service.Unmanaged,
+		Status:    service.Status,
+	}
+
+	rb, err := json.Marshal(service.Spec)
+	if err != nil {
+		return nil, err
+	}
+	err = json.Unmarshal(rb, &spec.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	return &spec, nil
+}
+
+// GetOsdSpec retrieves an OSD specification by service ID
+func (c *Client) GetOsdSpec(serviceID string) (*OsdSpec, error) {
+	service, err := c.GetService(OsdSpecServiceName(serviceID))
+	if err != nil {
+		return nil, err
+	}
+
+	return osdSpecFromService(service)
+}
+
+// ApplyOsdSpec creates or updates an OSD specification; the orchestrator then
+// deploys OSDs on the matching devices
+func (c *Client) ApplyOsdSpec(spec OsdSpec) error {
+	driveGroup := map[string]interface{}{
+		"service_type": "osd",
+		"service_id":   spec.ServiceID,
+		"placement":    spec.Placement,
+		"unmanaged":    spec.Unmanaged,
+		"spec":         spec.Spec,
+	}
+	payload := map[string]interface{}{
+		"method":      "drive_groups",
+		"data":        []interface{}{driveGroup},
+		"tracking_id": spec.ServiceID,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/osd", bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteOsdSpec removes an OSD specification; the deployed OSDs are kept
+func (c *Client) DeleteOsdSpec(serviceID string) error {
+	return c.DeleteService(OsdSpecServiceName(serviceID))
+}
@@ -0,0 +1,56 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOsdSpecFromService(t *testing.T) {
+	input := `{
+		"service_name": "osd.hdd",
+		"service_type": "osd",
+		"service_id": "hdd",
+		"placement": {"label": "osd"},
+		"spec": {
+			"data_devices": {"rotational": true, "size": "4T:"},
+			"db_devices": {"paths": ["/dev/nvme0n1"]},
+			"encrypted": true,
+			"filter_logic": "AND",
+			"objectstore": "bluestore",
+			"osds_per_device": 2
+		},
+		"status": {"running": 12, "size": 12}
+	}`
+
+	var service Service
+	if err := json.Unmarshal([]byte(input), &service); err != nil {
+		t.Fatalf("unmarshal service: %s", err)
+	}
+
+	spec, err := osdSpecFromService(&service)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if spec.ServiceID != "hdd" || spec.Placement.Label != "osd" || spec.Status.Running != 12 {
+		t.Errorf("unexpected service fields: %+v", spec)
+	}
+	if spec.Spec.DataDevices == nil || spec.Spec.DataDevices.Rotational == nil || !*spec.Spec.DataDevices.Rotational || spec.Spec.DataDevices.Size != "4T:" {
+		t.Errorf("unexpected data_devices: %+v", spec.Spec.DataDevices)
+	}
+	if spec.Spec.DBDevices == nil || len(spec.Spec.DBDevices.Paths) != 1 || spec.Spec.DBDevices.Paths[0] != "/dev/nvme0n1" {
+		t.Errorf("unexpected db_devices: %+v", spec.Spec.DBDevices)
+	}
+	if spec.Spec.WALDevices != nil {
+		t.Errorf("expected no wal_devices, got %+v", spec.Spec.WALDevices)
+	}
+	if !spec.Spec.Encrypted || spec.Spec.OsdsPerDevice != 2 {
+		t.Errorf("unexpected settings: %+v", spec.Spec)
+	}
+}
+
+func TestOsdSpecServiceName(t *testing.T) {
+	if got := OsdSpecServiceName("hdd"); got != "osd.hdd" {
+		t.Errorf("got %q, want %q", got, "osd.hdd")
+	}
+}
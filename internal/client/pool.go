@@ -30,10 +30,13 @@ type Pool struct {
 	PgNum               int               `json:"pg_num,omitempty"`
 	Size                int               `json:"size,omitempty"`
 	RuleName            string            `json:"rule_name,omitempty"`
+	ErasureCodeProfile  string            `json:"erasure_code_profile,omitempty"`
 	QuotaMaxBytes       int64             `json:"quota_max_bytes,omitempty"`
+	QuotaMaxObjects     int64             `json:"quota_max_objects,omitempty"`
 	ApplicationMetadata []string          `json:"application_metadata,omitempty"`
 	RbdMirroring        bool              `json:"rbd_mirroring,omitempty"`
 	Configuration       PoolConfiguration `json:"configuration,omitempty"`
+	Stats               PoolStats         `json:"-"`
 }
 
 // CreatePool creates a new pool
@@ -47,45 +50,103 @@ func (c *Client) CreatePool(pool Pool) error {
 	return err
 }
 
-// GetPool retrieves a pool by name
+// PoolStat is a pool statistic as reported by the dashboard
+type PoolStat struct {
+	Latest float64 `json:"latest"`
+}
+
+// PoolStats represents the usage statistics of a pool
+type PoolStats struct {
+	Stored      PoolStat `json:"stored"`
+	BytesUsed   PoolStat `json:"bytes_used"`
+	Objects     PoolStat `json:"objects"`
+	MaxAvail    PoolStat `json:"max_avail"`
+	PercentUsed PoolStat `json:"percent_used"`
+}
+
+// poolResponse is a pool as returned by the API. The field names differ from the POST request.
+type poolResponse struct {
+	PoolID              int       `json:"pool"`
+	PoolName            string    `json:"pool_name"`
+	Type                string    `json:"type"`
+	PgAutoscaleMode     string    `json:"pg_autoscale_mode"`
+	PgNum               int       `json:"pg_num"`
+	Size                int       `json:"size"`
+	CrushRule           string    `json:"crush_rule"`
+	ErasureCodeProfile  string    `json:"erasure_code_profile"`
+	QuotaMaxBytes       int64     `json:"quota_max_bytes"`
+	QuotaMaxObjects     int64     `json:"quota_max_objects"`
+	ApplicationMetadata []string  `json:"application_metadata"`
+	Stats               PoolStats `json:"stats"`
+}
+
+func (r poolResponse) pool() Pool {
+	return Pool{
+		PoolName:            r.PoolName,
+		Type:                r.Type,
+		PgAutoscaleMode:     r.PgAutoscaleMode,
+		PgNum:               r.PgNum,
+		Size:                r.Size,
+		RuleName:            r.CrushRule,
+		ErasureCodeProfile:  r.ErasureCodeProfile,
+		QuotaMaxBytes:       r.QuotaMaxBytes,
+		QuotaMaxObjects:     r.QuotaMaxObjects,
+		ApplicationMetadata: r.ApplicationMetadata,
+		Stats:               r.Stats,
+	}
+}
+
+// QuotaBytesRatio returns the used fraction of the byte quota, or false if the pool has no byte quota
+func (p Pool) QuotaBytesRatio() (float64, bool) {
+	if p.QuotaMaxBytes <= 0 {
+		return 0, false
+	}
+	return p.Stats.Stored.Latest / float64(p.QuotaMaxBytes), true
+}
+
+// QuotaObjectsRatio returns the used fraction of the object quota, or false if the pool has no object quota
+func (p Pool) QuotaObjectsRatio() (float64, bool) {
+	if p.QuotaMaxObjects <= 0 {
+		return 0, false
+	}
+	return p.Stats.Objects.Latest / float64(p.QuotaMaxObjects), true
+}
+
+// GetPool retrieves a pool by name, including its usage statistics
 func (c *Client) GetPool(name string) (*Pool, error) {
-	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/pool/%s", name), nil)
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/pool/%s?stats=true", name), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// GET response has different field names than POST request
-	type GetPoolResponse struct {
-		PoolID              int      `json:"pool"`
-		PoolName            string   `json:"pool_name"`
-		Type                string   `json:"type"`
-		PgAutoscaleMode     string   `json:"pg_autoscale_mode"`
-		PgNum               int      `json:"pg_num"`
-		Size                int      `json:"size"`
-		CrushRule           string   `json:"crush_rule"`
-		QuotaMaxBytes       int64    `json:"quota_max_bytes"`
-		ApplicationMetadata []string `json:"application_metadata"`
+	var getResp poolResponse
+	err = json.Unmarshal(resp, &getResp)
+	if err != nil {
+		return nil, err
 	}
 
-	var getResp GetPoolResponse
-	err = json.Unmarshal(resp, &getResp)
+	pool := getResp.pool()
+	return &pool, nil
+}
+
+// ListPools retrieves all pools, including their usage statistics
+func (c *Client) ListPools() ([]Pool, error) {
+	resp, err := c.DoRequest("GET", "/api/pool?stats=true", nil)
 	if err != nil {
 		return nil, err
 	}
 
-	// Map response to Pool struct
-	pool := &Pool{
-		PoolName:            getResp.PoolName,
-		Type:                getResp.Type,
-		PgAutoscaleMode:     getResp.PgAutoscaleMode,
-		PgNum:               getResp.PgNum,
-		Size:                getResp.Size,
-		RuleName:            getResp.CrushRule,
-		QuotaMaxBytes:       getResp.QuotaMaxBytes,
-		ApplicationMetadata: getResp.ApplicationMetadata,
+	var listResp []poolResponse
+	err = json.Unmarshal(resp, &listResp)
+	if err != nil {
+		return nil, err
 	}
 
-	return pool, nil
+	pools := make([]Pool, 0, len(listResp))
+	for _, r := range listResp {
+		pools = append(pools, r.pool())
+	}
+	return pools, nil
 }
 
 // PoolUpdate represents the fields that can be updated on a pool
@@ -0,0 +1,266 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// RBD mirroring modes of a pool and of an image
+const (
+	RbdMirrorModeDisabled = "disabled"
+	RbdMirrorModeImage    = "image"
+	RbdMirrorModePool     = "pool"
+
+	RbdImageMirrorModeSnapshot = "snapshot"
+	RbdImageMirrorModeJournal  = "journal"
+)
+
+// RbdMirrorPeer represents a remote peer of a mirrored pool
+type RbdMirrorPeer struct {
+	UUID        string `json:"uuid"`
+	SiteName    string `json:"site_name"`
+	ClusterName string `json:"cluster_name"`
+	ClientID    string `json:"client_id"`
+	MonHost     string `json:"mon_host"`
+	Direction   string `json:"direction"`
+}
+
+// RbdMirrorPoolSummary represents the mirroring status of a pool
+type RbdMirrorPoolSummary struct {
+	Name        string   `json:"name"`
+	MirrorMode  string   `json:"mirror_mode"`
+	Health      string   `json:"health"`
+	HealthColor string   `json:"health_color"`
+	PeerUUIDs   []string `json:"peer_uuids"`
+}
+
+// RbdMirrorDaemonSummary represents an rbd-mirror daemon
+type RbdMirrorDaemonSummary struct {
+	ID          string `json:"id"`
+	ServerHost  string `json:"server_hostname"`
+	Version     string `json:"version"`
+	Health      string `json:"health"`
+	HealthColor string `json:"health_color"`
+}
+
+// RbdMirrorSummary represents the mirroring status of the cluster
+type RbdMirrorSummary struct {
+	SiteName    string `json:"site_name"`
+	Status      int    `json:"status"`
+	ContentData struct {
+		Daemons      []RbdMirrorDaemonSummary `json:"daemons"`
+		Pools        []RbdMirrorPoolSummary   `json:"pools"`
+		ImageError   []json.RawMessage        `json:"image_error"`
+		ImageSyncing []json.RawMessage        `json:"image_syncing"`
+		ImageReady   []json.RawMessage        `json:"image_ready"`
+	} `json:"content_data"`
+}
+
+// RbdImageMirroring represents the mirroring settings of an RBD image
+type RbdImageMirroring struct {
+	Name             string `json:"name"`
+	MirrorMode       string `json:"mirror_mode"`
+	Primary          bool   `json:"primary"`
+	ScheduleInterval string `json:"schedule_interval"`
+}
+
+// RbdImageSpec returns the image spec (pool/image) used to address an image
+func RbdImageSpec(pool, image string) string {
+	return url.PathEscape(pool + "/" + image)
+}
+
+// GetRbdMirrorSiteName retrieves the mirroring site name of the cluster
+func (c *Client) GetRbdMirrorSiteName() (string, error) {
+	resp, err := c.DoRequest("GET", "/api/block/mirroring/site_name", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		SiteName string `json:"site_name"`
+	}
+	err = json.Unmarshal(resp, &result)
+	if err != nil {
+		return "", err
+	}
+
+	return result.SiteName, nil
+}
+
+// SetRbdMirrorSiteName sets the mirroring site name of the cluster
+func (c *Client) SetRbdMirrorSiteName(siteName string) error {
+	rb, err := json.Marshal(map[string]string{"site_name": siteName})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", "/api/block/mirroring/site_name", bytes.NewBuffer(rb))
+	return err
+}
+
+// GetRbdMirrorPoolMode retrieves the mirroring mode of a pool
+func (c *Client) GetRbdMirrorPoolMode(pool string) (string, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/block/mirroring/pool/%s", url.PathEscape(pool)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		MirrorMode string `json:"mirror_mode"`
+	}
+	err = json.Unmarshal(resp, &result)
+	if err != nil {
+		return "", err
+	}
+
+	return result.MirrorMode, nil
+}
+
+// SetRbdMirrorPoolMode sets the mirroring mode of a pool (disabled, image or pool)
+func (c *Client) SetRbdMirrorPoolMode(pool, mode string) error {
+	rb, err := json.Marshal(map[string]string{"mirror_mode": mode})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/block/mirroring/pool/%s", url.PathEscape(pool)), bytes.NewBuffer(rb))
+	return err
+}
+
+// CreateRbdMirrorBootstrapToken creates a bootstrap token used by a remote cluster to peer with a pool
+func (c *Client) CreateRbdMirrorBootstrapToken(pool string) (string, error) {
+	resp, err := c.DoRequest("POST", fmt.Sprintf("/api/block/mirroring/pool/%s/bootstrap/token", url.PathEscape(pool)), nil)
+	if err != nil {
+		return "", err
+	}
+
+	var result struct {
+		Token string `json:"token"`
+	}
+	err = json.Unmarshal(resp, &result)
+	if err != nil {
+		return "", err
+	}
+
+	return result.Token, nil
+}
+
+// ImportRbdMirrorBootstrapToken peers a pool with a remote cluster using its
+// bootstrap token. direction is either "rx-only" or "rx-tx".
+func (c *Client) ImportRbdMirrorBootstrapToken(pool, direction, token string) error {
+	rb, err := json.Marshal(map[string]string{"direction": direction, "token": token})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", fmt.Sprintf("/api/block/mirroring/pool/%s/bootstrap/peer", url.PathEscape(pool)), bytes.NewBuffer(rb))
+	return err
+}
+
+// ListRbdMirrorPeers retrieves the peer UUIDs of a pool
+func (c *Client) ListRbdMirrorPeers(pool string) ([]string, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/block/mirroring/pool/%s/peer", url.PathEscape(pool)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var uuids []string
+	err = json.Unmarshal(resp, &uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	return uuids, nil
+}
+
+// GetRbdMirrorPeer retrieves a peer of a pool by UUID
+func (c *Client) GetRbdMirrorPeer(pool, uuid string) (*RbdMirrorPeer, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/block/mirroring/pool/%s/peer/%s", url.PathEscape(pool), url.PathEscape(uuid)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var peer RbdMirrorPeer
+	err = json.Unmarshal(resp, &peer)
+	if err != nil {
+		return nil, err
+	}
+	peer.UUID = uuid
+
+	return &peer, nil
+}
+
+// DeleteRbdMirrorPeer removes a peer from a pool
+func (c *Client) DeleteRbdMirrorPeer(pool, uuid string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/block/mirroring/pool/%s/peer/%s", url.PathEscape(pool), url.PathEscape(uuid)), nil)
+	return err
+}
+
+// GetRbdMirrorSummary retrieves the mirroring status of the cluster
+func (c *Client) GetRbdMirrorSummary() (*RbdMirrorSummary, error) {
+	resp, err := c.DoRequest("GET", "/api/block/mirroring/summary", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary RbdMirrorSummary
+	err = json.Unmarshal(resp, &summary)
+	if err != nil {
+		return nil, err
+	}
+
+	return &summary, nil
+}
+
+// GetRbdImageMirroring retrieves the mirroring settings of an image
+func (c *Client) GetRbdImageMirroring(pool, image string) (*RbdImageMirroring, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/block/image/%s", RbdImageSpec(pool, image)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var mirroring RbdImageMirroring
+	err = json.Unmarshal(resp, &mirroring)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mirroring, nil
+}
+
+// EnableRbdImageMirroring enables snapshot or journal based mirroring of an
+// image. For snapshot mirroring, a non-empty interval (e.g. 1h) schedules
+// mirror snapshots; an empty interval removes the schedule.
+func (c *Client) EnableRbdImageMirroring(pool, image, mode, interval string) error {
+	payload := map[string]interface{}{
+		"enable_mirror": true,
+		"mirror_mode":   mode,
+	}
+	if mode == RbdImageMirrorModeSnapshot {
+		if interval != "" {
+			payload["schedule_interval"] = interval
+		} else {
+			payload["remove_scheduling"] = true
+		}
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/block/image/%s", RbdImageSpec(pool, image)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DisableRbdImageMirroring disables mirroring of an image
+func (c *Client) DisableRbdImageMirroring(pool, image string) error {
+	rb, err := json.Marshal(map[string]interface{}{"enable_mirror": false})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/block/image/%s", RbdImageSpec(pool, image)), bytes.NewBuffer(rb))
+	return err
+}
@@ -0,0 +1,306 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RGW bucket versioning and MFA delete states
+const (
+	RGWStateEnabled   = "Enabled"
+	RGWStateSuspended = "Suspended"
+	RGWStateDisabled  = "Disabled"
+)
+
+// RGWBucket represents an RGW bucket (for GET responses)
+type RGWBucket struct {
+	Bucket                   string            `json:"bucket"`
+	ID                       string            `json:"id"`
+	Owner                    string            `json:"owner"`
+	PlacementRule            string            `json:"placement_rule"`
+	Versioning               string            `json:"versioning"`
+	MFADelete                string            `json:"mfa_delete"`
+	LockEnabled              RGWBool           `json:"lock_enabled"`
+	LockMode                 string            `json:"lock_mode"`
+	LockRetentionPeriodDays  int64             `json:"lock_retention_period_days"`
+	LockRetentionPeriodYears int64             `json:"lock_retention_period_years"`
+	BucketPolicy             json.RawMessage   `json:"bucket_policy"`
+	Tagset                   map[string]string `json:"tagset"`
+}
+
+// Policy returns the bucket policy as a JSON string, or an empty string if none is set
+func (b *RGWBucket) Policy() string {
+	if len(b.BucketPolicy) == 0 || string(b.BucketPolicy) == "null" {
+		return ""
+	}
+
+	// Some releases return the policy as a JSON encoded string
+	var s string
+	if err := json.Unmarshal(b.BucketPolicy, &s); err == nil {
+		return s
+	}
+	return string(b.BucketPolicy)
+}
+
+// RGWBucketRequest represents the payload for creating/updating an RGW bucket
+type RGWBucketRequest struct {
+	Bucket                   string            `json:"bucket,omitempty"`
+	BucketID                 string            `json:"bucket_id,omitempty"`
+	UID                      string            `json:"uid"`
+	PlacementTarget          string            `json:"placement_target,omitempty"`
+	VersioningState          string            `json:"versioning_state,omitempty"`
+	MFADelete                string            `json:"mfa_delete,omitempty"`
+	MFATokenSerial           string            `json:"mfa_token_serial,omitempty"`
+	MFATokenPin              string            `json:"mfa_token_pin,omitempty"`
+	LockEnabled              bool              `json:"lock_enabled"`
+	LockMode                 string            `json:"lock_mode,omitempty"`
+	LockRetentionPeriodDays  int64             `json:"lock_retention_period_days,omitempty"`
+	LockRetentionPeriodYears int64             `json:"lock_retention_period_years,omitempty"`
+	EncryptionState          bool              `json:"encryption_state"`
+	EncryptionType           string            `json:"encryption_type,omitempty"`
+	KeyID                    string            `json:"key_id,omitempty"`
+	BucketPolicy             string            `json:"bucket_policy"`
+	Tags                     map[string]string `json:"tags"`
+}
+
+// GetRGWBucket retrieves an RGW bucket by name
+func (c *Client) GetRGWBucket(name string) (*RGWBucket, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/rgw/bucket/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, rgwNotFound(err)
+	}
+
+	var bucket RGWBucket
+	err = json.Unmarshal(resp, &bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &bucket, nil
+}
+
+// CreateRGWBucket creates a new RGW bucket
+func (c *Client) CreateRGWBucket(bucket RGWBucketRequest) error {
+	rb, err := json.Marshal(bucket)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/rgw/bucket", bytes.NewBuffer(rb))
+	return err
+}
+
+// UpdateRGWBucket updates an existing RGW bucket. A different owner relinks
+// the bucket to that user.
+func (c *Client) UpdateRGWBucket(name string, bucket RGWBucketRequest) error {
+	bucket.Bucket = ""
+	rb, err := json.Marshal(bucket)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/rgw/bucket/%s", url.PathEscape(name)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteRGWBucket deletes an RGW bucket, optionally purging its objects
+func (c *Client) DeleteRGWBucket(name string, purgeObjects bool) error {
+	query := url.Values{"purge_objects": {strconv.FormatBool(purgeObjects)}}
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/rgw/bucket/%s?%s", url.PathEscape(name), query.Encode()), nil)
+	return err
+}
+
+// GetRGWBucketLifecycle retrieves the lifecycle configuration of a bucket as
+// returned by the API, or an empty string if none is set
+func (c *Client) GetRGWBucketLifecycle(name string) (string, error) {
+	query := url.Values{"bucket_name": {name}}
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/rgw/bucket/getLifecycle?%s", query.Encode()), nil)
+	if err != nil {
+		return "", rgwNotFound(err)
+	}
+
+	trimmed := bytes.TrimSpace(resp)
+	if len(trimmed) == 0 || string(trimmed) == "null" || string(trimmed) == "{}" {
+		return "", nil
+	}
+	return string(trimmed), nil
+}
+
+// SetRGWBucketLifecycle sets the lifecycle configuration of a bucket, in
+// either the S3 XML or JSON format. An empty lifecycle removes the configuration.
+func (c *Client) SetRGWBucketLifecycle(name, lifecycle string) error {
+	payload := map[string]string{
+		"bucket_name": name,
+		"lifecycle":   lifecycle,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", "/api/rgw/bucket/setLifecycle", bytes.NewBuffer(rb))
+	return err
+}
+
+// rgwLifecycleKeys maps the list keys of the S3 JSON lifecycle format to the
+// element names of the XML format
+var rgwLifecycleKeys = map[string]string{
+	"Rules":                        "Rule",
+	"Transitions":                  "Transition",
+	"NoncurrentVersionTransitions": "NoncurrentVersionTransition",
+	"Tags":                         "Tag",
+}
+
+// NormalizeRGWLifecycle converts a lifecycle configuration in the S3 XML or
+// JSON format into a canonical JSON document, so that configurations can be
+// compared regardless of their format: list keys use their XML element names,
+// single-element lists are unwrapped and all values are strings.
+func NormalizeRGWLifecycle(lifecycle string) (string, error) {
+	lifecycle = strings.TrimSpace(lifecycle)
+
+	var doc interface{}
+	if strings.HasPrefix(lifecycle, "<") {
+		d := xml.NewDecoder(strings.NewReader(lifecycle))
+		for {
+			tok, err := d.Token()
+			if err != nil {
+				return "", fmt.Errorf("invalid lifecycle XML: %w", err)
+			}
+			if start, ok := tok.(xml.StartElement); ok {
+				doc, err = xmlLifecycleValue(d)
+				if err != nil {
+					return "", err
+				}
+				doc = map[string]interface{}{start.Name.Local: doc}
+				break
+			}
+		}
+	} else if err := json.Unmarshal([]byte(lifecycle), &doc); err != nil {
+		return "", fmt.Errorf("invalid lifecycle JSON: %w", err)
+	}
+
+	if m, ok := doc.(map[string]interface{}); ok {
+		if root, ok := m["LifecycleConfiguration"]; ok {
+			doc = root
+		}
+	}
+
+	rb, err := json.Marshal(normalizeLifecycleValue(doc))
+	if err != nil {
+		return "", err
+	}
+	return string(rb), nil
+}
+
+// xmlLifecycleValue decodes the content of the current XML element into a
+// string (for leaves) or a map of child elements, repeated elements becoming lists
+func xmlLifecycleValue(d *xml.Decoder) (interface{}, error) {
+	var text strings.Builder
+	children := map[string]interface{}{}
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil, fmt.Errorf("invalid lifecycle XML: unexpected end of document")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid lifecycle XML: %w", err)
+		}
+
+		switch t := tok.(type) {
+		case xml.StartElement:
+			child, err := xmlLifecycleValue(d)
+			if err != nil {
+				return nil, err
+			}
+			name := t.Name.Local
+			switch existing := children[name].(type) {
+			case nil:
+				children[name] = child
+			case []interface{}:
+				children[name] = append(existing, child)
+			default:
+				children[name] = []interface{}{existing, child}
+			}
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			if len(children) == 0 {
+				return strings.TrimSpace(text.String()), nil
+			}
+			return children, nil
+		}
+	}
+}
+
+func normalizeLifecycleValue(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, child := range t {
+			if name, ok := rgwLifecycleKeys[k]; ok {
+				k = name
+			}
+			m[k] = normalizeLifecycleValue(child)
+		}
+		return m
+	case []interface{}:
+		if len(t) == 1 {
+			return normalizeLifecycleValue(t[0])
+		}
+		l := make([]interface{}, 0, len(t))
+		for _, child := range t {
+			l = append(l, normalizeLifecycleValue(child))
+		}
+		return l
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case nil:
+		return ""
+	default:
+		return t
+	}
+}
+
+// RGWBucketEncryption represents the default server-side encryption of a bucket
+type RGWBucketEncryption struct {
+	Rules []struct {
+		ApplyServerSideEncryptionByDefault struct {
+			SSEAlgorithm   string `json:"SSEAlgorithm"`
+			KMSMasterKeyID string `json:"KMSMasterKeyID"`
+		} `json:"ApplyServerSideEncryptionByDefault"`
+	} `json:"Rules"`
+}
+
+// GetRGWBucketEncryption retrieves the default encryption algorithm (e.g. AES256)
+// and KMS key ID of a bucket. The algorithm is empty if encryption is disabled.
+func (c *Client) GetRGWBucketEncryption(name string) (algorithm, keyID string, err error) {
+	query := url.Values{"bucket_name": {name}}
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/rgw/bucket/getEncryption?%s", query.Encode()), nil)
+	if err != nil {
+		// RGW reports buckets without encryption configuration as an error
+		if strings.Contains(err.Error(), "ServerSideEncryptionConfigurationNotFoundError") {
+			return "", "", nil
+		}
+		return "", "", rgwNotFound(err)
+	}
+
+	var encryption RGWBucketEncryption
+	err = json.Unmarshal(resp, &encryption)
+	if err != nil {
+		return "", "", err
+	}
+	if len(encryption.Rules) == 0 {
+		return "", "", nil
+	}
+
+	rule := encryption.Rules[0].ApplyServerSideEncryptionByDefault
+	return rule.SSEAlgorithm, rule.KMSMasterKeyID, nil
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"testing"
+)
+
+func TestNormalizeRGWLifecycle(t *testing.T) {
+	const xmlPolicy = `<LifecycleConfiguration xmlns="http://s3.amazonaws.com/doc/2006-03-01/">
+	<Rule>
+		<ID>expire-logs</ID>
+		<Filter><Prefix>logs/</Prefix></Filter>
+		<Status>Enabled</Status>
+		<Expiration><Days>30</Days></Expiration>
+	</Rule>
+</LifecycleConfiguration>`
+	const jsonPolicy = `{"Rules": [{"ID": "expire-logs", "Status": "Enabled", "Filter": {"Prefix": "logs/"}, "Expiration": {"Days": 30}}]}`
+
+	cases := []struct {
+		name      string
+		a, b      string
+		wantEqual bool
+	}{
+		{name: "xml and json", a: xmlPolicy, b: jsonPolicy, wantEqual: true},
+		{name: "wrapped json", a: `{"LifecycleConfiguration": ` + jsonPolicy + `}`, b: jsonPolicy, wantEqual: true},
+		{
+			name: "transitions",
+			a: `<LifecycleConfiguration><Rule><ID>tier</ID><Status>Enabled</Status>` +
+				`<Transition><Days>30</Days><StorageClass>COLD</StorageClass></Transition>` +
+				`<Transition><Days>90</Days><StorageClass>GLACIER</StorageClass></Transition></Rule></LifecycleConfiguration>`,
+			b: `{"Rules": [{"ID": "tier", "Status": "Enabled", "Transitions": [` +
+				`{"Days": 30, "StorageClass": "COLD"}, {"Days": 90, "StorageClass": "GLACIER"}]}]}`,
+			wantEqual: true,
+		},
+		{
+			name:      "changed expiration",
+			a:         xmlPolicy,
+			b:         `{"Rules": [{"ID": "expire-logs", "Status": "Enabled", "Filter": {"Prefix": "logs/"}, "Expiration": {"Days": 60}}]}`,
+			wantEqual: false,
+		},
+		{
+			name:      "disabled rule",
+			a:         xmlPolicy,
+			b:         `{"Rules": [{"ID": "expire-logs", "Status": "Disabled", "Filter": {"Prefix": "logs/"}, "Expiration": {"Days": 30}}]}`,
+			wantEqual: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := NormalizeRGWLifecycle(tc.a)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			b, err := NormalizeRGWLifecycle(tc.b)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if (a == b) != tc.wantEqual {
+				t.Errorf("got %s and %s, want equal: %v", a, b, tc.wantEqual)
+			}
+		})
+	}
+
+	for _, invalid := range []string{"<LifecycleConfiguration><Rule>", "{not json"} {
+		if _, err := NormalizeRGWLifecycle(invalid); err == nil {
+			t.Errorf("expected an error for %q", invalid)
+		}
+	}
+}
@@ -0,0 +1,302 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// RGWRealm represents an RGW multisite realm
+type RGWRealm struct {
+	ID            string `json:"id"`
+	Name          string `json:"name"`
+	CurrentPeriod string `json:"current_period"`
+	Epoch         int64  `json:"epoch"`
+}
+
+// RGWPlacementTarget represents a placement target of a zonegroup
+type RGWPlacementTarget struct {
+	Name           string   `json:"name"`
+	Tags           []string `json:"tags"`
+	StorageClasses []string `json:"storage_classes,omitempty"`
+}
+
+// RGWZonegroupZone represents a zone as listed in its zonegroup
+type RGWZonegroupZone struct {
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Endpoints []string `json:"endpoints"`
+}
+
+// RGWZonegroup represents an RGW multisite zonegroup
+type RGWZonegroup struct {
+	ID               string               `json:"id"`
+	Name             string               `json:"name"`
+	IsMaster         RGWBool              `json:"is_master"`
+	Endpoints        []string             `json:"endpoints"`
+	MasterZone       string               `json:"master_zone"`
+	Zones            []RGWZonegroupZone   `json:"zones"`
+	PlacementTargets []RGWPlacementTarget `json:"placement_targets"`
+	DefaultPlacement string               `json:"default_placement"`
+	RealmID          string               `json:"realm_id"`
+}
+
+// RGWZonegroupRequest represents the payload for creating/updating a zonegroup
+type RGWZonegroupRequest struct {
+	RealmName        string               `json:"realm_name"`
+	ZonegroupName    string               `json:"zonegroup_name"`
+	Default          bool                 `json:"default"`
+	Master           bool                 `json:"master"`
+	Endpoints        string               `json:"zonegroup_endpoints"`
+	PlacementTargets []RGWPlacementTarget `json:"placement_targets,omitempty"`
+}
+
+// RGWPlacementPool represents the pools of a zone for a placement target
+type RGWPlacementPool struct {
+	Key string `json:"key"`
+	Val struct {
+		IndexPool      string `json:"index_pool"`
+		DataExtraPool  string `json:"data_extra_pool"`
+		StorageClasses struct {
+			Standard struct {
+				DataPool string `json:"data_pool"`
+			} `json:"STANDARD"`
+		} `json:"storage_classes"`
+	} `json:"val"`
+}
+
+// RGWZone represents an RGW multisite zone
+type RGWZone struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	SystemKey struct {
+		AccessKey string `json:"access_key"`
+		SecretKey string `json:"secret_key"`
+	} `json:"system_key"`
+	PlacementPools []RGWPlacementPool `json:"placement_pools"`
+	RealmID        string             `json:"realm_id"`
+}
+
+// RGWZoneRequest represents the payload for creating/updating a zone
+type RGWZoneRequest struct {
+	ZoneName      string `json:"zone_name"`
+	ZonegroupName string `json:"zonegroup_name"`
+	Default       bool   `json:"default"`
+	Master        bool   `json:"master"`
+	Endpoints     string `json:"zone_endpoints"`
+	AccessKey     string `json:"access_key,omitempty"`
+	SecretKey     string `json:"secret_key,omitempty"`
+}
+
+// RGWZonePlacementRequest represents the payload for setting the pools of a placement target in a zone
+type RGWZonePlacementRequest struct {
+	ZoneName        string `json:"zone_name"`
+	ZonegroupName   string `json:"zonegroup_name"`
+	PlacementTarget string `json:"placement_target"`
+	DataPool        string `json:"data_pool"`
+	IndexPool       string `json:"index_pool"`
+	DataExtraPool   string `json:"data_extra_pool"`
+	StorageClass    string `json:"storage_class"`
+}
+
+// GetRGWRealm retrieves a realm by name
+func (c *Client) GetRGWRealm(name string) (*RGWRealm, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/rgw/realm/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, rgwMultisiteNotFound(err)
+	}
+
+	var realm RGWRealm
+	err = json.Unmarshal(resp, &realm)
+	if err != nil {
+		return nil, err
+	}
+
+	return &realm, nil
+}
+
+// CreateRGWRealm creates a new realm
+func (c *Client) CreateRGWRealm(name string, isDefault bool) error {
+	payload := map[string]interface{}{
+		"realm_name": name,
+		"default":    isDefault,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/rgw/realm", bytes.NewBuffer(rb))
+	return err
+}
+
+// UpdateRGWRealm updates an existing realm
+func (c *Client) UpdateRGWRealm(name string, isDefault bool) error {
+	payload := map[string]interface{}{
+		"realm_name":     name,
+		"new_realm_name": name,
+		"default":        isDefault,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/rgw/realm/%s", url.PathEscape(name)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteRGWRealm deletes a realm
+func (c *Client) DeleteRGWRealm(name string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/rgw/realm/%s", url.PathEscape(name)), nil)
+	return err
+}
+
+// GetRGWZonegroup retrieves a zonegroup by name
+func (c *Client) GetRGWZonegroup(name string) (*RGWZonegroup, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/rgw/zonegroup/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, rgwMultisiteNotFound(err)
+	}
+
+	var zonegroup RGWZonegroup
+	err = json.Unmarshal(resp, &zonegroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zonegroup, nil
+}
+
+// CreateRGWZonegroup creates a new zonegroup
+func (c *Client) CreateRGWZonegroup(zonegroup RGWZonegroupRequest) error {
+	rb, err := json.Marshal(zonegroup)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/rgw/zonegroup", bytes.NewBuffer(rb))
+	return err
+}
+
+// UpdateRGWZonegroup updates an existing zonegroup
+func (c *Client) UpdateRGWZonegroup(zonegroup RGWZonegroupRequest) error {
+	payload := struct {
+		RGWZonegroupRequest
+		NewZonegroupName string `json:"new_zonegroup_name"`
+	}{zonegroup, zonegroup.ZonegroupName}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/rgw/zonegroup/%s", url.PathEscape(zonegroup.ZonegroupName)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteRGWZonegroup deletes a zonegroup. Its pools are kept.
+func (c *Client) DeleteRGWZonegroup(name string) error {
+	query := url.Values{"delete_pools": {"false"}}
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/rgw/zonegroup/%s?%s", url.PathEscape(name), query.Encode()), nil)
+	return err
+}
+
+// GetRGWZone retrieves a zone by name
+func (c *Client) GetRGWZone(name string) (*RGWZone, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/rgw/zone/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, rgwMultisiteNotFound(err)
+	}
+
+	var zone RGWZone
+	err = json.Unmarshal(resp, &zone)
+	if err != nil {
+		return nil, err
+	}
+
+	return &zone, nil
+}
+
+// CreateRGWZone creates a new zone in a zonegroup
+func (c *Client) CreateRGWZone(zone RGWZoneRequest) error {
+	rb, err := json.Marshal(zone)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/rgw/zone", bytes.NewBuffer(rb))
+	return err
+}
+
+// UpdateRGWZone updates an existing zone
+func (c *Client) UpdateRGWZone(zone RGWZoneRequest) error {
+	payload := struct {
+		RGWZoneRequest
+		NewZoneName string `json:"new_zone_name"`
+	}{zone, zone.ZoneName}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/rgw/zone/%s", url.PathEscape(zone.ZoneName)), bytes.NewBuffer(rb))
+	return err
+}
+
+// SetRGWZonePlacement sets the pools used by a zone for a placement target
+func (c *Client) SetRGWZonePlacement(placement RGWZonePlacementRequest) error {
+	payload := struct {
+		RGWZonePlacementRequest
+		NewZoneName string `json:"new_zone_name"`
+	}{placement, placement.ZoneName}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/rgw/zone/%s", url.PathEscape(placement.ZoneName)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteRGWZone deletes a zone from its zonegroup. Its pools are kept.
+func (c *Client) DeleteRGWZone(name, zonegroupName string) error {
+	query := url.Values{
+		"zonegroup_name": {zonegroupName},
+		"delete_pools":   {"false"},
+	}
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/rgw/zone/%s?%s", url.PathEscape(name), query.Encode()), nil)
+	return err
+}
+
+// CommitRGWPeriod updates and commits the current period of a realm, making
+// realm, zonegroup and zone changes effective
+func (c *Client) CommitRGWPeriod(realmName string) error {
+	payload := map[string]interface{}{
+		"realm_name": realmName,
+		"commit":     true,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/rgw/period", bytes.NewBuffer(rb))
+	return err
+}
+
+// JoinRGWEndpoints converts a list of endpoints into the comma separated form expected by the API
+func JoinRGWEndpoints(endpoints []string) string {
+	return strings.Join(endpoints, ",")
+}
+
+// rgwMultisiteNotFound maps the ENOENT errors of radosgw-admin
+// (e.g. "failed to init realm: (2) No such file or directory") to ErrNotFound
+func rgwMultisiteNotFound(err error) error {
+	if err != nil && !errors.Is(err, ErrNotFound) && strings.Contains(err.Error(), "No such file or directory") {
+		return fmt.Errorf("%s: %w", err, ErrNotFound)
+	}
+	return rgwNotFound(err)
+}
@@ -0,0 +1,181 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// RGWBool is a boolean that radosgw-admin reports as a bool, a number or a string
+// depending on the Ceph release (e.g. "suspended": 0, "system": "false")
+type RGWBool bool
+
+func (b *RGWBool) UnmarshalJSON(data []byte) error {
+	s := strings.Trim(string(data), `"`)
+	if s == "" || s == "null" {
+		*b = false
+		return nil
+	}
+	if n, err := strconv.Atoi(s); err == nil {
+		*b = n != 0
+		return nil
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	*b = RGWBool(v)
+	return nil
+}
+
+// RGWQuota represents a user or bucket quota of an RGW user
+type RGWQuota struct {
+	Enabled    bool  `json:"enabled"`
+	MaxSizeKB  int64 `json:"max_size_kb"`
+	MaxObjects int64 `json:"max_objects"`
+}
+
+// RGWKey represents an S3 key pair of an RGW user
+type RGWKey struct {
+	User      string `json:"user"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+}
+
+// RGWUser represents an RGW user (for GET responses)
+type RGWUser struct {
+	UserID      string   `json:"user_id"`
+	Tenant      string   `json:"tenant"`
+	DisplayName string   `json:"display_name"`
+	Email       string   `json:"email"`
+	MaxBuckets  int64    `json:"max_buckets"`
+	Suspended   RGWBool  `json:"suspended"`
+	System      RGWBool  `json:"system"`
+	Keys        []RGWKey `json:"keys"`
+	UserQuota   RGWQuota `json:"user_quota"`
+	BucketQuota RGWQuota `json:"bucket_quota"`
+}
+
+// RGWUserRequest represents the payload for creating/updating an RGW user
+type RGWUserRequest struct {
+	UID         string `json:"uid,omitempty"`
+	DisplayName string `json:"display_name"`
+	Email       string `json:"email"`
+	MaxBuckets  int64  `json:"max_buckets"`
+	Suspended   bool   `json:"suspended"`
+	System      bool   `json:"system"`
+	GenerateKey bool   `json:"generate_key"`
+}
+
+// RGWUserID returns the full RGW user ID of a user, prefixed by its tenant if any
+func RGWUserID(tenant, uid string) string {
+	if tenant == "" {
+		return uid
+	}
+	return tenant + "$" + uid
+}
+
+// rgwNotFound maps the "NoSuchUser"/"NoSuchKey" style errors of RGW to ErrNotFound
+func rgwNotFound(err error) error {
+	if err != nil && !errors.Is(err, ErrNotFound) && strings.Contains(err.Error(), "NoSuch") {
+		return fmt.Errorf("%s: %w", err, ErrNotFound)
+	}
+	return err
+}
+
+// GetRGWUser retrieves an RGW user by its full user ID
+func (c *Client) GetRGWUser(uid string) (*RGWUser, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/rgw/user/%s", url.PathEscape(uid)), nil)
+	if err != nil {
+		return nil, rgwNotFound(err)
+	}
+
+	var user RGWUser
+	err = json.Unmarshal(resp, &user)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// CreateRGWUser creates a new RGW user
+func (c *Client) CreateRGWUser(user RGWUserRequest) error {
+	rb, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/rgw/user", bytes.NewBuffer(rb))
+	return err
+}
+
+// UpdateRGWUser updates an existing RGW user
+func (c *Client) UpdateRGWUser(uid string, user RGWUserRequest) error {
+	user.UID = ""
+	user.GenerateKey = false
+	rb, err := json.Marshal(user)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/rgw/user/%s", url.PathEscape(uid)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteRGWUser deletes an RGW user
+func (c *Client) DeleteRGWUser(uid string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/rgw/user/%s", url.PathEscape(uid)), nil)
+	return err
+}
+
+// SetRGWUserQuota sets the user or bucket quota of an RGW user.
+// quotaType is either "user" or "bucket".
+func (c *Client) SetRGWUserQuota(uid, quotaType string, quota RGWQuota) error {
+	payload := map[string]interface{}{
+		"quota_type":  quotaType,
+		"enabled":     quota.Enabled,
+		"max_size_kb": quota.MaxSizeKB,
+		"max_objects": quota.MaxObjects,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("PUT", fmt.Sprintf("/api/rgw/user/%s/quota", url.PathEscape(uid)), bytes.NewBuffer(rb))
+	return err
+}
+
+// CreateRGWUserKey adds an S3 key pair to an RGW user. If accessKey and
+// secretKey are empty, a key pair is generated.
+func (c *Client) CreateRGWUserKey(uid, accessKey, secretKey string) error {
+	payload := map[string]interface{}{
+		"key_type":     "s3",
+		"generate_key": accessKey == "" && secretKey == "",
+	}
+	if accessKey != "" {
+		payload["access_key"] = accessKey
+	}
+	if secretKey != "" {
+		payload["secret_key"] = secretKey
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", fmt.Sprintf("/api/rgw/user/%s/key", url.PathEscape(uid)), bytes.NewBuffer(rb))
+	return err
+}
+
+// DeleteRGWUserKey removes an S3 key pair from an RGW user
+func (c *Client) DeleteRGWUserKey(uid, accessKey string) error {
+	query := url.Values{"key_type": {"s3"}, "access_key": {accessKey}}
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/rgw/user/%s/key?%s", url.PathEscape(uid), query.Encode()), nil)
+	return err
+}
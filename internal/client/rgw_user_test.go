@@ -0,0 +1,43 @@
+package client
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRGWBoolUnmarshal(t *testing.T) {
+	tests := map[string]bool{
+		`true`:    true,
+		`false`:   false,
+		`1`:       true,
+		`0`:       false,
+		`"true"`:  true,
+		`"false"`: false,
+		`null`:    false,
+	}
+
+	for input, want := range tests {
+		var got RGWBool
+		if err := json.Unmarshal([]byte(input), &got); err != nil {
+			t.Errorf("unmarshal %s: unexpected error: %s", input, err)
+			continue
+		}
+		if bool(got) != want {
+			t.Errorf("unmarshal %s = %v, want %v", input, got, want)
+		}
+	}
+
+	var b RGWBool
+	if err := json.Unmarshal([]byte(`"maybe"`), &b); err == nil {
+		t.Error("expected an error for an invalid boolean")
+	}
+}
+
+func TestRGWUserID(t *testing.T) {
+	if got := RGWUserID("", "alice"); got != "alice" {
+		t.Errorf("got %q, want %q", got, "alice")
+	}
+	if got := RGWUserID("acme", "alice"); got != "acme$alice" {
+		t.Errorf("got %q, want %q", got, "acme$alice")
+	}
+}
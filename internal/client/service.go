@@ -0,0 +1,91 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// ServicePlacement represents an orchestrator placement specification
+type ServicePlacement struct {
+	Count int      `json:"count,omitempty"`
+	Hosts []string `json:"hosts,omitempty"`
+	Label string   `json:"label,omitempty"`
+}
+
+// ServiceSpec represents an orchestrator service specification
+type ServiceSpec struct {
+	ServiceType string           `json:"service_type"`
+	ServiceID   string           `json:"service_id,omitempty"`
+	Placement   ServicePlacement `json:"placement"`
+	Unmanaged   bool             `json:"unmanaged,omitempty"`
+	// Spec holds the service type specific settings (e.g. rgw_frontend_port)
+	Spec map[string]interface{} `json:"spec,omitempty"`
+}
+
+// ServiceStatus represents the deployment status of an orchestrator service
+type ServiceStatus struct {
+	Running int `json:"running"`
+	Size    int `json:"size"`
+}
+
+// Service represents an orchestrator service (for GET responses), i.e. its
+// service specification along with its status
+type Service struct {
+	ServiceName string                 `json:"service_name"`
+	ServiceType string                 `json:"service_type"`
+	ServiceID   string                 `json:"service_id"`
+	Placement   ServicePlacement       `json:"placement"`
+	Unmanaged   bool                   `json:"unmanaged"`
+	Spec        map[string]interface{} `json:"spec"`
+	Status      ServiceStatus          `json:"status"`
+}
+
+// ServiceName returns the orchestrator name of a service (e.g. rgw.default)
+func ServiceName(serviceType, serviceID string) string {
+	if serviceID == "" {
+		return serviceType
+	}
+	return serviceType + "." + serviceID
+}
+
+// ApplyServiceSpec creates or updates an orchestrator service (ceph orch apply)
+func (c *Client) ApplyServiceSpec(spec ServiceSpec) error {
+	payload := map[string]interface{}{
+		"service_name": ServiceName(spec.ServiceType, spec.ServiceID),
+		"service_spec": spec,
+	}
+	rb, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.DoRequest("POST", "/api/service", bytes.NewBuffer(rb))
+	return err
+}
+
+// GetService retrieves an orchestrator service by name
+func (c *Client) GetService(name string) (*Service, error) {
+	resp, err := c.DoRequest("GET", fmt.Sprintf("/api/service/%s", url.PathEscape(name)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var service Service
+	err = json.Unmarshal(resp, &service)
+	if err != nil {
+		return nil, err
+	}
+	if service.ServiceName == "" {
+		return nil, fmt.Errorf("service %s %w", name, ErrNotFound)
+	}
+
+	return &service, nil
+}
+
+// DeleteService removes an orchestrator service and its daemons (ceph orch rm)
+func (c *Client) DeleteService(name string) error {
+	_, err := c.DoRequest("DELETE", fmt.Sprintf("/api/service/%s", url.PathEscape(name)), nil)
+	return err
+}
@@ -44,9 +44,14 @@ func BuildCapabilities(pools []string) []Capability {
 
 // CreateUser creates a new Ceph user
 func (c *Client) CreateUser(entity string, pools []string) error {
+	return c.CreateUserWithCapabilities(entity, BuildCapabilities(pools))
+}
+
+// CreateUserWithCapabilities creates a new Ceph user with the given capabilities
+func (c *Client) CreateUserWithCapabilities(entity string, caps []Capability) error {
 	req := UserRequest{
 		UserEntity:   entity,
-		Capabilities: BuildCapabilities(pools),
+		Capabilities: caps,
 	}
 
 	rb, err := json.Marshal(req)
@@ -65,8 +70,17 @@ type UserResponse struct {
 	Key    string            `json:"key"`
 }
 
-// GetUser retrieves a user by entity name (e.g., client.admin)
-func (c *Client) GetUser(entity string) (*UserResponse, error) {
+// UserRbdPools returns the pools of the "profile rbd pool=" OSD capability of a user
+func UserRbdPools(caps map[string]string) []string {
+	pools := []string{}
+	if cap, ok := caps["osd"]; ok && strings.HasPrefix(cap, "profile rbd pool=") {
+		pools = append(pools, strings.TrimPrefix(cap, "profile rbd pool="))
+	}
+	return pools
+}
+
+// ListUsers retrieves all users
+func (c *Client) ListUsers() ([]UserResponse, error) {
 	resp, err := c.DoRequest("GET", "/api/cluster/user", nil)
 	if err != nil {
 		return nil, err
@@ -78,20 +92,35 @@ func (c *Client) GetUser(entity string) (*UserResponse, error) {
 		return nil, err
 	}
 
+	return users, nil
+}
+
+// GetUser retrieves a user by entity name (e.g., client.admin)
+func (c *Client) GetUser(entity string) (*UserResponse, error) {
+	users, err := c.ListUsers()
+	if err != nil {
+		return nil, err
+	}
+
 	for _, u := range users {
 		if u.Entity == entity {
 			return &u, nil
 		}
 	}
 
-	return nil, fmt.Errorf("user %s not found", entity)
+	return nil, fmt.Errorf("user %s %w", entity, ErrNotFound)
 }
 
 // UpdateUser updates an existing user
 func (c *Client) UpdateUser(entity string, pools []string) error {
+	return c.UpdateUserWithCapabilities(entity, BuildCapabilities(pools))
+}
+
+// UpdateUserWithCapabilities replaces the capabilities of an existing user
+func (c *Client) UpdateUserWithCapabilities(entity string, caps []Capability) error {
 	req := UserRequest{
 		UserEntity:   entity,
-		Capabilities: BuildCapabilities(pools),
+		Capabilities: caps,
 	}
 
 	rb, err := json.Marshal(req)
@@ -0,0 +1,44 @@
+package client
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestUserRbdPools(t *testing.T) {
+	tests := []struct {
+		name string
+		caps map[string]string
+		want []string
+	}{
+		{
+			name: "rbd profile",
+			caps: map[string]string{"mon": "allow r", "osd": "profile rbd pool=volumes"},
+			want: []string{"volumes"},
+		},
+		{
+			name: "other osd capability",
+			caps: map[string]string{"mon": "allow r", "osd": "allow rwx"},
+			want: []string{},
+		},
+		{
+			name: "no osd capability",
+			caps: map[string]string{"mds": "allow rw"},
+			want: []string{},
+		},
+		{
+			name: "no capabilities",
+			caps: nil,
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := UserRbdPools(tt.caps)
+			if got == nil || !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
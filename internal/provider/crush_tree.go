@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"sort"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+)
+
+// crushTree indexes the CRUSH hierarchy returned by the API to resolve
+// parents, aggregated weights and device classes of buckets.
+type crushTree struct {
+	nodes   map[int]client.CrushNode
+	parents map[int]int
+	order   []int
+}
+
+func newCrushTree(nodes []client.CrushNode) *crushTree {
+	t := &crushTree{
+		nodes:   make(map[int]client.CrushNode, len(nodes)),
+		parents: make(map[int]int),
+	}
+	for _, n := range nodes {
+		t.nodes[n.ID] = n
+		t.order = append(t.order, n.ID)
+		for _, child := range n.Children {
+			t.parents[child] = n.ID
+		}
+	}
+	return t
+}
+
+// parentName returns the name of the parent bucket, or an empty string for roots
+func (t *crushTree) parentName(id int) string {
+	parent, ok := t.parents[id]
+	if !ok {
+		return ""
+	}
+	return t.nodes[parent].Name
+}
+
+// childNames returns the names of the direct children of a bucket
+func (t *crushTree) childNames(id int) []string {
+	names := []string{}
+	for _, child := range t.nodes[id].Children {
+		if n, ok := t.nodes[child]; ok {
+			names = append(names, n.Name)
+		}
+	}
+	return names
+}
+
+// weight returns the CRUSH weight of a node. Bucket weights are the sum of
+// the weights of the OSDs below them.
+func (t *crushTree) weight(id int) float64 {
+	n := t.nodes[id]
+	if !n.IsBucket() {
+		return n.CrushWeight
+	}
+	var total float64
+	for _, child := range n.Children {
+		total += t.weight(child)
+	}
+	return total
+}
+
+// deviceClasses returns the sorted, de-duplicated device classes of the
+// OSDs below a node.
+func (t *crushTree) deviceClasses(id int) []string {
+	seen := map[string]bool{}
+	t.collectDeviceClasses(id, seen)
+
+	classes := []string{}
+	for class := range seen {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	return classes
+}
+
+func (t *crushTree) collectDeviceClasses(id int, seen map[string]bool) {
+	n := t.nodes[id]
+	if !n.IsBucket() {
+		if n.DeviceClass != "" {
+			seen[n.DeviceClass] = true
+		}
+		return
+	}
+	for _, child := range n.Children {
+		t.collectDeviceClasses(child, seen)
+	}
+}
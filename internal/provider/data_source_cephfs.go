@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephCephFSDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephCephFSDataSource{}
+
+type CephCephFSDataSource struct {
+	client *client.Client
+}
+
+type CephCephFSDataSourceModel struct {
+	Name         types.String `tfsdk:"name"`
+	FsID         types.Int64  `tfsdk:"fs_id"`
+	DataPools    types.List   `tfsdk:"data_pools"`
+	MetadataPool types.String `tfsdk:"metadata_pool"`
+	MaxMDS       types.Int64  `tfsdk:"max_mds"`
+}
+
+func NewCephCephFSDataSource() datasource.DataSource {
+	return &CephCephFSDataSource{}
+}
+
+func (d *CephCephFSDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs"
+}
+
+func (d *CephCephFSDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Look up an existing CephFS file system",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the file system",
+				Required:            true,
+			},
+			"fs_id": schema.Int64Attribute{
+				MarkdownDescription: "The file system ID (fscid)",
+				Computed:            true,
+			},
+			"data_pools": schema.ListAttribute{
+				MarkdownDescription: "The names of the data pools, the default data pool first",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"metadata_pool": schema.StringAttribute{
+				MarkdownDescription: "The name of the metadata pool",
+				Computed:            true,
+			},
+			"max_mds": schema.Int64Attribute{
+				MarkdownDescription: "The number of active MDS daemons",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CephCephFSDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephCephFSDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephCephFSDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fs, err := d.client.GetCephFS(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CephFS: %s", err))
+		return
+	}
+
+	dataPools, metadataPool, err := d.client.GetCephFSPools(fs.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CephFS pools: %s", err))
+		return
+	}
+
+	data.FsID = types.Int64Value(int64(fs.ID))
+	data.MetadataPool = types.StringValue(metadataPool)
+	data.MaxMDS = types.Int64Value(int64(fs.MDSMap.MaxMDS))
+
+	pools, diags := types.ListValueFrom(ctx, types.StringType, dataPools)
+	resp.Diagnostics.Append(diags...)
+	data.DataPools = pools
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
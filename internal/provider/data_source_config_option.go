@@ -0,0 +1,188 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephConfigOptionDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephConfigOptionDataSource{}
+
+type CephConfigOptionDataSource struct {
+	client *client.Client
+}
+
+type CephConfigOptionDataSourceModel struct {
+	Name               types.String           `tfsdk:"name"`
+	Type               types.String           `tfsdk:"type"`
+	Level              types.String           `tfsdk:"level"`
+	Description        types.String           `tfsdk:"description"`
+	Default            types.String           `tfsdk:"default"`
+	DaemonDefault      types.String           `tfsdk:"daemon_default"`
+	EnumValues         types.List             `tfsdk:"enum_values"`
+	Min                types.String           `tfsdk:"min"`
+	Max                types.String           `tfsdk:"max"`
+	CanUpdateAtRuntime types.Bool             `tfsdk:"can_update_at_runtime"`
+	Services           types.List             `tfsdk:"services"`
+	Values             []CephConfigValueModel `tfsdk:"values"`
+}
+
+type CephConfigValueModel struct {
+	Section types.String `tfsdk:"section"`
+	Mask    types.String `tfsdk:"mask"`
+	Value   types.String `tfsdk:"value"`
+}
+
+func NewCephConfigOptionDataSource() datasource.DataSource {
+	return &CephConfigOptionDataSource{}
+}
+
+func (d *CephConfigOptionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config_option"
+}
+
+func (d *CephConfigOptionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the definition of a configuration option and the values set in the configuration database",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The option name (e.g., osd_memory_target)",
+				Required:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The value type (e.g., str, int, uint, float, bool, size, secs, addr, uuid)",
+				Computed:            true,
+			},
+			"level": schema.StringAttribute{
+				MarkdownDescription: "The option level (basic, advanced or dev)",
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the option",
+				Computed:            true,
+			},
+			"default": schema.StringAttribute{
+				MarkdownDescription: "The default value",
+				Computed:            true,
+			},
+			"daemon_default": schema.StringAttribute{
+				MarkdownDescription: "The default value for daemons, when it differs from the default",
+				Computed:            true,
+			},
+			"enum_values": schema.ListAttribute{
+				MarkdownDescription: "The allowed values, when restricted",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"min": schema.StringAttribute{
+				MarkdownDescription: "The minimum value, when bounded",
+				Computed:            true,
+			},
+			"max": schema.StringAttribute{
+				MarkdownDescription: "The maximum value, when bounded",
+				Computed:            true,
+			},
+			"can_update_at_runtime": schema.BoolAttribute{
+				MarkdownDescription: "Whether a change takes effect without restarting the daemons",
+				Computed:            true,
+			},
+			"services": schema.ListAttribute{
+				MarkdownDescription: "The services using the option (e.g., osd, mon)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"values": schema.ListNestedAttribute{
+				MarkdownDescription: "The values set in the configuration database",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"section": schema.StringAttribute{
+							MarkdownDescription: "The daemons the value applies to (e.g., global, osd)",
+							Computed:            true,
+						},
+						"mask": schema.StringAttribute{
+							MarkdownDescription: "The host or device class mask, if reported",
+							Computed:            true,
+						},
+						"value": schema.StringAttribute{
+							MarkdownDescription: "The value",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CephConfigOptionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephConfigOptionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephConfigOptionDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	option, err := d.client.GetConfigOption(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config option: %s", err))
+		return
+	}
+
+	data.Type = types.StringValue(option.Type)
+	data.Level = types.StringValue(option.Level)
+	data.Description = types.StringValue(option.Desc)
+	data.Default = types.StringValue(client.RawString(option.Default))
+	data.DaemonDefault = optionalString(client.RawString(option.DaemonDefault))
+	data.Min = optionalString(client.RawString(option.Min))
+	data.Max = optionalString(client.RawString(option.Max))
+	data.CanUpdateAtRuntime = types.BoolValue(option.CanUpdateAtRuntime)
+
+	enumValues := option.EnumValues
+	if enumValues == nil {
+		enumValues = []string{}
+	}
+	list, diags := types.ListValueFrom(ctx, types.StringType, enumValues)
+	resp.Diagnostics.Append(diags...)
+	data.EnumValues = list
+
+	services := option.Services
+	if services == nil {
+		services = []string{}
+	}
+	list, diags = types.ListValueFrom(ctx, types.StringType, services)
+	resp.Diagnostics.Append(diags...)
+	data.Services = list
+
+	data.Values = []CephConfigValueModel{}
+	for _, v := range option.Value {
+		data.Values = append(data.Values, CephConfigValueModel{
+			Section: types.StringValue(v.Section),
+			Mask:    optionalString(v.Mask),
+			Value:   types.StringValue(v.Value),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
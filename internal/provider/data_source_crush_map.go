@@ -0,0 +1,201 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephCrushMapDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephCrushMapDataSource{}
+
+type CephCrushMapDataSource struct {
+	client *client.Client
+}
+
+type CephCrushMapDataSourceModel struct {
+	Buckets       []CephCrushBucketModel `tfsdk:"buckets"`
+	Roots         types.List             `tfsdk:"roots"`
+	BucketTypes   types.List             `tfsdk:"bucket_types"`
+	DeviceClasses types.List             `tfsdk:"device_classes"`
+}
+
+type CephCrushBucketModel struct {
+	ID            types.Int64   `tfsdk:"id"`
+	Name          types.String  `tfsdk:"name"`
+	Type          types.String  `tfsdk:"type"`
+	Weight        types.Float64 `tfsdk:"weight"`
+	Parent        types.String  `tfsdk:"parent"`
+	Children      types.List    `tfsdk:"children"`
+	DeviceClasses types.List    `tfsdk:"device_classes"`
+}
+
+func NewCephCrushMapDataSource() datasource.DataSource {
+	return &CephCrushMapDataSource{}
+}
+
+func (d *CephCrushMapDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crush_map"
+}
+
+func (d *CephCrushMapDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the CRUSH bucket topology (roots, datacenters, racks, hosts) of the cluster",
+		Attributes: map[string]schema.Attribute{
+			"buckets": schema.ListNestedAttribute{
+				MarkdownDescription: "List of CRUSH buckets",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The bucket ID (negative)",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The bucket name",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The bucket type (e.g., root, datacenter, rack, host)",
+							Computed:            true,
+						},
+						"weight": schema.Float64Attribute{
+							MarkdownDescription: "The CRUSH weight of the bucket (sum of the OSD weights below it)",
+							Computed:            true,
+						},
+						"parent": schema.StringAttribute{
+							MarkdownDescription: "The name of the parent bucket, empty for roots",
+							Computed:            true,
+						},
+						"children": schema.ListAttribute{
+							MarkdownDescription: "The names of the direct children (buckets or OSDs)",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"device_classes": schema.ListAttribute{
+							MarkdownDescription: "The device classes of the OSDs below the bucket",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"roots": schema.ListAttribute{
+				MarkdownDescription: "The names of the root buckets",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"bucket_types": schema.ListAttribute{
+				MarkdownDescription: "The bucket types in use, usable as failure domains",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"device_classes": schema.ListAttribute{
+				MarkdownDescription: "All device classes in use",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CephCrushMapDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephCrushMapDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephCrushMapDataSourceModel
+
+	nodes, err := d.client.GetCrushTree()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CRUSH map: %s", err))
+		return
+	}
+
+	tree := newCrushTree(nodes)
+
+	roots := []string{}
+	bucketTypes := []string{}
+	seenTypes := map[string]bool{}
+	data.Buckets = []CephCrushBucketModel{}
+	for _, id := range tree.order {
+		node := tree.nodes[id]
+		if !node.IsBucket() {
+			continue
+		}
+
+		bucket := CephCrushBucketModel{
+			ID:     types.Int64Value(int64(node.ID)),
+			Name:   types.StringValue(node.Name),
+			Type:   types.StringValue(node.Type),
+			Weight: types.Float64Value(tree.weight(node.ID)),
+			Parent: types.StringValue(tree.parentName(node.ID)),
+		}
+
+		children, diags := types.ListValueFrom(ctx, types.StringType, tree.childNames(node.ID))
+		resp.Diagnostics.Append(diags...)
+		bucket.Children = children
+
+		classes, diags := types.ListValueFrom(ctx, types.StringType, tree.deviceClasses(node.ID))
+		resp.Diagnostics.Append(diags...)
+		bucket.DeviceClasses = classes
+
+		data.Buckets = append(data.Buckets, bucket)
+
+		if _, ok := tree.parents[node.ID]; !ok {
+			roots = append(roots, node.Name)
+		}
+		if !seenTypes[node.Type] {
+			seenTypes[node.Type] = true
+			bucketTypes = append(bucketTypes, node.Type)
+		}
+	}
+
+	allClasses := map[string]bool{}
+	for _, node := range nodes {
+		if !node.IsBucket() && node.DeviceClass != "" {
+			allClasses[node.DeviceClass] = true
+		}
+	}
+	deviceClasses := []string{}
+	for class := range allClasses {
+		deviceClasses = append(deviceClasses, class)
+	}
+	sort.Strings(deviceClasses)
+
+	rootList, diags := types.ListValueFrom(ctx, types.StringType, roots)
+	resp.Diagnostics.Append(diags...)
+	data.Roots = rootList
+
+	typeList, diags := types.ListValueFrom(ctx, types.StringType, bucketTypes)
+	resp.Diagnostics.Append(diags...)
+	data.BucketTypes = typeList
+
+	classList, diags := types.ListValueFrom(ctx, types.StringType, deviceClasses)
+	resp.Diagnostics.Append(diags...)
+	data.DeviceClasses = classList
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -18,8 +18,21 @@ type CephCrushRuleDataSource struct {
 }
 
 type CephCrushRuleDataSourceModel struct {
-	Name   types.String `tfsdk:"name"`
-	RuleID types.Int64  `tfsdk:"rule_id"`
+	Name          types.String             `tfsdk:"name"`
+	RuleID        types.Int64              `tfsdk:"rule_id"`
+	Type          types.String             `tfsdk:"type"`
+	Root          types.String             `tfsdk:"root"`
+	FailureDomain types.String             `tfsdk:"failure_domain"`
+	DeviceClass   types.String             `tfsdk:"device_class"`
+	Steps         []CephCrushRuleStepModel `tfsdk:"steps"`
+}
+
+type CephCrushRuleStepModel struct {
+	Op       types.String `tfsdk:"op"`
+	Item     types.Int64  `tfsdk:"item"`
+	ItemName types.String `tfsdk:"item_name"`
+	Num      types.Int64  `tfsdk:"num"`
+	Type     types.String `tfsdk:"type"`
 }
 
 func NewCephCrushRuleDataSource() datasource.DataSource {
@@ -31,21 +44,104 @@ func (d *CephCrushRuleDataSource) Metadata(ctx context.Context, req datasource.M
 }
 
 func (d *CephCrushRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := crushRuleDataSourceAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the CRUSH rule",
+		Required:            true,
+	}
+
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Look up an existing Ceph CRUSH rule",
-		Attributes: map[string]schema.Attribute{
-			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the CRUSH rule",
-				Required:            true,
-			},
-			"rule_id": schema.Int64Attribute{
-				MarkdownDescription: "The CRUSH rule ID",
-				Computed:            true,
+		Attributes:          attributes,
+	}
+}
+
+// crushRuleDataSourceAttributes returns the computed attributes describing a
+// CRUSH rule, shared by the ceph_crush_rule and ceph_crush_rules data sources.
+func crushRuleDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			MarkdownDescription: "The name of the CRUSH rule",
+			Computed:            true,
+		},
+		"rule_id": schema.Int64Attribute{
+			MarkdownDescription: "The CRUSH rule ID",
+			Computed:            true,
+		},
+		"type": schema.StringAttribute{
+			MarkdownDescription: "The rule type (replicated or erasure)",
+			Computed:            true,
+		},
+		"root": schema.StringAttribute{
+			MarkdownDescription: "The root bucket, derived from the first `take` step",
+			Computed:            true,
+		},
+		"failure_domain": schema.StringAttribute{
+			MarkdownDescription: "The failure domain type, derived from the first `choose`/`chooseleaf` step",
+			Computed:            true,
+		},
+		"device_class": schema.StringAttribute{
+			MarkdownDescription: "The device class, derived from the `take` item (e.g., `default~ssd`). Empty if the rule is not restricted to a class.",
+			Computed:            true,
+		},
+		"steps": schema.ListNestedAttribute{
+			MarkdownDescription: "The ordered list of rule steps",
+			Computed:            true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"op": schema.StringAttribute{
+						MarkdownDescription: "The step operation (e.g., take, chooseleaf_firstn, choose_indep, emit)",
+						Computed:            true,
+					},
+					"item": schema.Int64Attribute{
+						MarkdownDescription: "The bucket ID used by a `take` step",
+						Computed:            true,
+					},
+					"item_name": schema.StringAttribute{
+						MarkdownDescription: "The bucket name used by a `take` step",
+						Computed:            true,
+					},
+					"num": schema.Int64Attribute{
+						MarkdownDescription: "The number of buckets to choose (0 means pool size)",
+						Computed:            true,
+					},
+					"type": schema.StringAttribute{
+						MarkdownDescription: "The bucket type to choose (e.g., host, rack)",
+						Computed:            true,
+					},
+				},
 			},
 		},
 	}
 }
 
+// flattenCrushRule maps a CRUSH rule API response to the data source model
+func flattenCrushRule(rule *client.CrushRuleResponse) CephCrushRuleDataSourceModel {
+	root, failureDomain, deviceClass := rule.Placement()
+
+	data := CephCrushRuleDataSourceModel{
+		Name:          types.StringValue(rule.RuleName),
+		RuleID:        types.Int64Value(int64(rule.RuleID)),
+		Type:          types.StringValue(rule.TypeName()),
+		Root:          types.StringValue(root),
+		FailureDomain: types.StringValue(failureDomain),
+		DeviceClass:   types.StringValue(deviceClass),
+		Steps:         []CephCrushRuleStepModel{},
+	}
+
+	for _, step := range rule.Steps {
+		data.Steps = append(data.Steps, CephCrushRuleStepModel{
+			Op:       types.StringValue(step.Op),
+			Item:     types.Int64Value(int64(step.Item)),
+			ItemName: types.StringValue(step.ItemName),
+			Num:      types.Int64Value(int64(step.Num)),
+			Type:     types.StringValue(step.Type),
+		})
+	}
+
+	return data
+}
+
 func (d *CephCrushRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -76,7 +172,7 @@ func (d *CephCrushRuleDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	data.RuleID = types.Int64Value(int64(rule.RuleID))
+	data = flattenCrushRule(rule)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
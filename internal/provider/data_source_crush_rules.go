@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+)
+
+var _ datasource.DataSource = &CephCrushRulesDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephCrushRulesDataSource{}
+
+type CephCrushRulesDataSource struct {
+	client *client.Client
+}
+
+type CephCrushRulesDataSourceModel struct {
+	Rules []CephCrushRuleDataSourceModel `tfsdk:"rules"`
+}
+
+func NewCephCrushRulesDataSource() datasource.DataSource {
+	return &CephCrushRulesDataSource{}
+}
+
+func (d *CephCrushRulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crush_rules"
+}
+
+func (d *CephCrushRulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List all Ceph CRUSH rules",
+		Attributes: map[string]schema.Attribute{
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "List of CRUSH rules in the cluster",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: crushRuleDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (d *CephCrushRulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephCrushRulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephCrushRulesDataSourceModel
+
+	rules, err := d.client.ListCrushRules()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list CRUSH rules: %s", err))
+		return
+	}
+
+	data.Rules = []CephCrushRuleDataSourceModel{}
+	for i := range rules {
+		data.Rules = append(data.Rules, flattenCrushRule(&rules[i]))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
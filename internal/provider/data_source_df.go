@@ -0,0 +1,252 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephDfDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephDfDataSource{}
+
+type CephDfDataSource struct {
+	client *client.Client
+}
+
+type CephDfDataSourceModel struct {
+	TotalBytes   types.Int64        `tfsdk:"total_bytes"`
+	AvailBytes   types.Int64        `tfsdk:"avail_bytes"`
+	UsedRawBytes types.Int64        `tfsdk:"used_raw_bytes"`
+	UsedRatio    types.Float64      `tfsdk:"used_ratio"`
+	Classes      []CephDfClassModel `tfsdk:"classes"`
+	Pools        []CephDfPoolModel  `tfsdk:"pools"`
+}
+
+type CephDfClassModel struct {
+	DeviceClass types.String  `tfsdk:"device_class"`
+	Osds        types.Int64   `tfsdk:"osds"`
+	TotalBytes  types.Int64   `tfsdk:"total_bytes"`
+	AvailBytes  types.Int64   `tfsdk:"avail_bytes"`
+	UsedBytes   types.Int64   `tfsdk:"used_bytes"`
+	UsedRatio   types.Float64 `tfsdk:"used_ratio"`
+}
+
+type CephDfPoolModel struct {
+	Name              types.String  `tfsdk:"name"`
+	StoredBytes       types.Int64   `tfsdk:"stored_bytes"`
+	UsedBytes         types.Int64   `tfsdk:"used_bytes"`
+	Objects           types.Int64   `tfsdk:"objects"`
+	MaxAvailBytes     types.Int64   `tfsdk:"max_avail_bytes"`
+	UsedRatio         types.Float64 `tfsdk:"used_ratio"`
+	QuotaMaxBytes     types.Int64   `tfsdk:"quota_max_bytes"`
+	QuotaMaxObjects   types.Int64   `tfsdk:"quota_max_objects"`
+	QuotaBytesRatio   types.Float64 `tfsdk:"quota_bytes_ratio"`
+	QuotaObjectsRatio types.Float64 `tfsdk:"quota_objects_ratio"`
+}
+
+func NewCephDfDataSource() datasource.DataSource {
+	return &CephDfDataSource{}
+}
+
+func (d *CephDfDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_df"
+}
+
+func (d *CephDfDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the capacity and usage of the cluster, like `ceph df`: the raw capacity in total and per device class, and the usage and quota utilization of each pool",
+		Attributes: map[string]schema.Attribute{
+			"total_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The raw capacity in bytes",
+				Computed:            true,
+			},
+			"avail_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The available raw capacity in bytes",
+				Computed:            true,
+			},
+			"used_raw_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The used raw capacity in bytes",
+				Computed:            true,
+			},
+			"used_ratio": schema.Float64Attribute{
+				MarkdownDescription: "The used fraction (0 to 1) of the raw capacity",
+				Computed:            true,
+			},
+			"classes": schema.ListNestedAttribute{
+				MarkdownDescription: "The raw capacity per device class, summed over the OSDs of the class",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"device_class": schema.StringAttribute{
+							MarkdownDescription: "The device class (e.g., hdd, ssd, nvme). Empty for OSDs without a device class",
+							Computed:            true,
+						},
+						"osds": schema.Int64Attribute{
+							MarkdownDescription: "The number of OSDs of the device class",
+							Computed:            true,
+						},
+						"total_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The raw capacity in bytes",
+							Computed:            true,
+						},
+						"avail_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The available raw capacity in bytes",
+							Computed:            true,
+						},
+						"used_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The used raw capacity in bytes",
+							Computed:            true,
+						},
+						"used_ratio": schema.Float64Attribute{
+							MarkdownDescription: "The used fraction (0 to 1) of the raw capacity",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"pools": schema.ListNestedAttribute{
+				MarkdownDescription: "The usage of each pool",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the pool",
+							Computed:            true,
+						},
+						"stored_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The bytes stored in the pool by clients, before replication or erasure coding",
+							Computed:            true,
+						},
+						"used_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The raw bytes used by the pool, including replication or erasure coding",
+							Computed:            true,
+						},
+						"objects": schema.Int64Attribute{
+							MarkdownDescription: "The number of objects in the pool",
+							Computed:            true,
+						},
+						"max_avail_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The bytes that can still be stored in the pool, given its CRUSH rule and the fullest OSD",
+							Computed:            true,
+						},
+						"used_ratio": schema.Float64Attribute{
+							MarkdownDescription: "The used fraction (0 to 1) of the pool capacity",
+							Computed:            true,
+						},
+						"quota_max_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The byte quota of the pool (0 for no quota)",
+							Computed:            true,
+						},
+						"quota_max_objects": schema.Int64Attribute{
+							MarkdownDescription: "The object quota of the pool (0 for no quota)",
+							Computed:            true,
+						},
+						"quota_bytes_ratio": schema.Float64Attribute{
+							MarkdownDescription: "The used fraction of the byte quota, based on the stored bytes. Null when the pool has no byte quota",
+							Computed:            true,
+						},
+						"quota_objects_ratio": schema.Float64Attribute{
+							MarkdownDescription: "The used fraction of the object quota. Null when the pool has no object quota",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CephDfDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephDfDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephDfDataSourceModel
+
+	health, err := d.client.GetHealth()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster capacity: %s", err))
+		return
+	}
+
+	osds, err := d.client.ListOsds()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list OSDs: %s", err))
+		return
+	}
+
+	pools, err := d.client.ListPools()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list pools: %s", err))
+		return
+	}
+
+	stats := health.Df.Stats
+	data.TotalBytes = types.Int64Value(stats.TotalBytes)
+	data.AvailBytes = types.Int64Value(stats.TotalAvailBytes)
+	data.UsedRawBytes = types.Int64Value(stats.TotalUsedRawBytes)
+	data.UsedRatio = usedRatio(stats.TotalUsedRawBytes, stats.TotalBytes)
+
+	data.Classes = []CephDfClassModel{}
+	for _, usage := range client.UsageByDeviceClass(osds) {
+		data.Classes = append(data.Classes, CephDfClassModel{
+			DeviceClass: types.StringValue(usage.DeviceClass),
+			Osds:        types.Int64Value(int64(usage.Osds)),
+			TotalBytes:  types.Int64Value(usage.TotalBytes),
+			AvailBytes:  types.Int64Value(usage.AvailBytes),
+			UsedBytes:   types.Int64Value(usage.UsedBytes),
+			UsedRatio:   usedRatio(usage.UsedBytes, usage.TotalBytes),
+		})
+	}
+
+	data.Pools = []CephDfPoolModel{}
+	for _, pool := range pools {
+		data.Pools = append(data.Pools, CephDfPoolModel{
+			Name:              types.StringValue(pool.PoolName),
+			StoredBytes:       types.Int64Value(int64(pool.Stats.Stored.Latest)),
+			UsedBytes:         types.Int64Value(int64(pool.Stats.BytesUsed.Latest)),
+			Objects:           types.Int64Value(int64(pool.Stats.Objects.Latest)),
+			MaxAvailBytes:     types.Int64Value(int64(pool.Stats.MaxAvail.Latest)),
+			UsedRatio:         types.Float64Value(pool.Stats.PercentUsed.Latest),
+			QuotaMaxBytes:     types.Int64Value(pool.QuotaMaxBytes),
+			QuotaMaxObjects:   types.Int64Value(pool.QuotaMaxObjects),
+			QuotaBytesRatio:   optionalRatio(pool.QuotaBytesRatio()),
+			QuotaObjectsRatio: optionalRatio(pool.QuotaObjectsRatio()),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// usedRatio returns the used fraction of a capacity, or 0 for an empty capacity
+func usedRatio(used, total int64) types.Float64 {
+	if total <= 0 {
+		return types.Float64Value(0)
+	}
+	return types.Float64Value(float64(used) / float64(total))
+}
+
+// optionalRatio returns the ratio, or null when there is none
+func optionalRatio(ratio float64, ok bool) types.Float64 {
+	if !ok {
+		return types.Float64Null()
+	}
+	return types.Float64Value(ratio)
+}
@@ -0,0 +1,208 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephHealthDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephHealthDataSource{}
+
+type CephHealthDataSource struct {
+	client *client.Client
+}
+
+type CephHealthDataSourceModel struct {
+	Status       types.String           `tfsdk:"status"`
+	Checks       []CephHealthCheckModel `tfsdk:"checks"`
+	PgStates     types.Map              `tfsdk:"pg_states"`
+	PgsTotal     types.Int64            `tfsdk:"pgs_total"`
+	OsdsTotal    types.Int64            `tfsdk:"osds_total"`
+	OsdsUp       types.Int64            `tfsdk:"osds_up"`
+	OsdsIn       types.Int64            `tfsdk:"osds_in"`
+	MonsTotal    types.Int64            `tfsdk:"mons_total"`
+	MonQuorum    types.List             `tfsdk:"mon_quorum"`
+	TotalBytes   types.Int64            `tfsdk:"total_bytes"`
+	AvailBytes   types.Int64            `tfsdk:"avail_bytes"`
+	UsedRawBytes types.Int64            `tfsdk:"used_raw_bytes"`
+	UsedRatio    types.Float64          `tfsdk:"used_ratio"`
+}
+
+type CephHealthCheckModel struct {
+	Type     types.String `tfsdk:"type"`
+	Severity types.String `tfsdk:"severity"`
+	Summary  types.String `tfsdk:"summary"`
+	Count    types.Int64  `tfsdk:"count"`
+	Muted    types.Bool   `tfsdk:"muted"`
+}
+
+func NewCephHealthDataSource() datasource.DataSource {
+	return &CephHealthDataSource{}
+}
+
+func (d *CephHealthDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_health"
+}
+
+func (d *CephHealthDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the health and status of the cluster: health checks, placement group states, OSD and monitor counts and capacity",
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The overall health status (HEALTH_OK, HEALTH_WARN or HEALTH_ERR)",
+				Computed:            true,
+			},
+			"checks": schema.ListNestedAttribute{
+				MarkdownDescription: "The raised health checks",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The check type (e.g., OSDMAP_FLAGS, MON_DOWN)",
+							Computed:            true,
+						},
+						"severity": schema.StringAttribute{
+							MarkdownDescription: "The severity (HEALTH_WARN or HEALTH_ERR)",
+							Computed:            true,
+						},
+						"summary": schema.StringAttribute{
+							MarkdownDescription: "The summary message",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "The number of affected entities",
+							Computed:            true,
+						},
+						"muted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the check is muted",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"pg_states": schema.MapAttribute{
+				MarkdownDescription: "The number of placement groups per state (e.g., active+clean)",
+				ElementType:         types.Int64Type,
+				Computed:            true,
+			},
+			"pgs_total": schema.Int64Attribute{
+				MarkdownDescription: "The total number of placement groups",
+				Computed:            true,
+			},
+			"osds_total": schema.Int64Attribute{
+				MarkdownDescription: "The number of OSDs",
+				Computed:            true,
+			},
+			"osds_up": schema.Int64Attribute{
+				MarkdownDescription: "The number of OSDs up",
+				Computed:            true,
+			},
+			"osds_in": schema.Int64Attribute{
+				MarkdownDescription: "The number of OSDs in",
+				Computed:            true,
+			},
+			"mons_total": schema.Int64Attribute{
+				MarkdownDescription: "The number of monitors",
+				Computed:            true,
+			},
+			"mon_quorum": schema.ListAttribute{
+				MarkdownDescription: "The names of the monitors in quorum",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"total_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The raw capacity in bytes",
+				Computed:            true,
+			},
+			"avail_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The available raw capacity in bytes",
+				Computed:            true,
+			},
+			"used_raw_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The used raw capacity in bytes",
+				Computed:            true,
+			},
+			"used_ratio": schema.Float64Attribute{
+				MarkdownDescription: "The used fraction (0 to 1) of the raw capacity",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CephHealthDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephHealthDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephHealthDataSourceModel
+
+	health, err := d.client.GetHealth()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read cluster health: %s", err))
+		return
+	}
+
+	data.Status = types.StringValue(health.Health.Status)
+	data.Checks = []CephHealthCheckModel{}
+	for _, check := range health.Health.Checks {
+		data.Checks = append(data.Checks, CephHealthCheckModel{
+			Type:     types.StringValue(check.Type),
+			Severity: types.StringValue(check.Severity),
+			Summary:  types.StringValue(check.Summary.Message),
+			Count:    types.Int64Value(int64(check.Summary.Count)),
+			Muted:    types.BoolValue(check.Muted),
+		})
+	}
+
+	pgStates := map[string]int64{}
+	var pgsTotal int64
+	for state, count := range health.PgInfo.Statuses {
+		pgStates[state] = int64(count)
+		pgsTotal += int64(count)
+	}
+	m, diags := types.MapValueFrom(ctx, types.Int64Type, pgStates)
+	resp.Diagnostics.Append(diags...)
+	data.PgStates = m
+	data.PgsTotal = types.Int64Value(pgsTotal)
+
+	var up, in int64
+	for _, osd := range health.OsdMap.Osds {
+		up += int64(osd.Up)
+		in += int64(osd.In)
+	}
+	data.OsdsTotal = types.Int64Value(int64(len(health.OsdMap.Osds)))
+	data.OsdsUp = types.Int64Value(up)
+	data.OsdsIn = types.Int64Value(in)
+
+	data.MonsTotal = types.Int64Value(int64(len(health.MonStatus.MonMap.Mons)))
+	quorum, diags := types.ListValueFrom(ctx, types.StringType, health.QuorumNames())
+	resp.Diagnostics.Append(diags...)
+	data.MonQuorum = quorum
+
+	stats := health.Df.Stats
+	data.TotalBytes = types.Int64Value(stats.TotalBytes)
+	data.AvailBytes = types.Int64Value(stats.TotalAvailBytes)
+	data.UsedRawBytes = types.Int64Value(stats.TotalUsedRawBytes)
+	data.UsedRatio = usedRatio(stats.TotalUsedRawBytes, stats.TotalBytes)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephHostsDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephHostsDataSource{}
+
+type CephHostsDataSource struct {
+	client *client.Client
+}
+
+type CephHostsDataSourceModel struct {
+	Label types.String              `tfsdk:"label"`
+	Hosts []CephHostDataSourceModel `tfsdk:"hosts"`
+}
+
+type CephHostDataSourceModel struct {
+	Hostname    types.String          `tfsdk:"hostname"`
+	Addr        types.String          `tfsdk:"addr"`
+	Labels      types.List            `tfsdk:"labels"`
+	Status      types.String          `tfsdk:"status"`
+	CephVersion types.String          `tfsdk:"ceph_version"`
+	Services    types.List            `tfsdk:"services"`
+	Devices     []CephHostDeviceModel `tfsdk:"devices"`
+}
+
+type CephHostDeviceModel struct {
+	Path      types.String `tfsdk:"path"`
+	Type      types.String `tfsdk:"type"`
+	Size      types.Int64  `tfsdk:"size"`
+	Available types.Bool   `tfsdk:"available"`
+}
+
+func NewCephHostsDataSource() datasource.DataSource {
+	return &CephHostsDataSource{}
+}
+
+func (d *CephHostsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_hosts"
+}
+
+func (d *CephHostsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the hosts of the orchestrator (cephadm) with their labels and storage device inventory",
+		Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Only list the hosts with this label",
+				Optional:            true,
+			},
+			"hosts": schema.ListNestedAttribute{
+				MarkdownDescription: "List of hosts",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "The hostname",
+							Computed:            true,
+						},
+						"addr": schema.StringAttribute{
+							MarkdownDescription: "The address the orchestrator connects to",
+							Computed:            true,
+						},
+						"labels": schema.ListAttribute{
+							MarkdownDescription: "The labels of the host",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the host (empty, maintenance or offline)",
+							Computed:            true,
+						},
+						"ceph_version": schema.StringAttribute{
+							MarkdownDescription: "The Ceph version running on the host",
+							Computed:            true,
+						},
+						"services": schema.ListAttribute{
+							MarkdownDescription: "The services with daemons on the host",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"devices": schema.ListNestedAttribute{
+							MarkdownDescription: "The storage devices of the host",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"path": schema.StringAttribute{
+										MarkdownDescription: "The device path (e.g., /dev/sdb)",
+										Computed:            true,
+									},
+									"type": schema.StringAttribute{
+										MarkdownDescription: "The device type (hdd or ssd)",
+										Computed:            true,
+									},
+									"size": schema.Int64Attribute{
+										MarkdownDescription: "The device size in bytes",
+										Computed:            true,
+									},
+									"available": schema.BoolAttribute{
+										MarkdownDescription: "Whether the device can be used for a new OSD",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CephHostsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephHostsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephHostsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hosts, err := d.client.ListHosts()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list hosts: %s", err))
+		return
+	}
+
+	data.Hosts = []CephHostDataSourceModel{}
+	for _, host := range hosts {
+		if !data.Label.IsNull() && !slices.Contains(host.Labels, data.Label.ValueString()) {
+			continue
+		}
+
+		devices, err := d.client.GetHostInventory(host.Hostname)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read inventory of host %s: %s", host.Hostname, err))
+			return
+		}
+
+		labels := host.Labels
+		if labels == nil {
+			labels = []string{}
+		}
+		labelList, diags := types.ListValueFrom(ctx, types.StringType, labels)
+		resp.Diagnostics.Append(diags...)
+
+		services := []string{}
+		for _, service := range host.Services {
+			services = append(services, client.ServiceName(service.Type, service.ID))
+		}
+		serviceList, diags := types.ListValueFrom(ctx, types.StringType, services)
+		resp.Diagnostics.Append(diags...)
+
+		model := CephHostDataSourceModel{
+			Hostname:    types.StringValue(host.Hostname),
+			Addr:        types.StringValue(host.Addr),
+			Labels:      labelList,
+			Status:      types.StringValue(host.Status),
+			CephVersion: types.StringValue(host.CephVersion),
+			Services:    serviceList,
+			Devices:     []CephHostDeviceModel{},
+		}
+		for _, device := range devices {
+			model.Devices = append(model.Devices, CephHostDeviceModel{
+				Path:      types.StringValue(device.Path),
+				Type:      types.StringValue(device.Type),
+				Size:      types.Int64Value(int64(device.SysAPI.Size)),
+				Available: types.BoolValue(device.Available),
+			})
+		}
+		data.Hosts = append(data.Hosts, model)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephMgrModulesDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephMgrModulesDataSource{}
+
+type CephMgrModulesDataSource struct {
+	client *client.Client
+}
+
+type CephMgrModulesDataSourceModel struct {
+	Modules  []CephMgrModuleDataSourceModel `tfsdk:"modules"`
+	Enabled  types.List                     `tfsdk:"enabled"`
+	AlwaysOn types.List                     `tfsdk:"always_on"`
+}
+
+type CephMgrModuleDataSourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	AlwaysOn types.Bool   `tfsdk:"always_on"`
+	Options  types.List   `tfsdk:"options"`
+}
+
+func NewCephMgrModulesDataSource() datasource.DataSource {
+	return &CephMgrModulesDataSource{}
+}
+
+func (d *CephMgrModulesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mgr_modules"
+}
+
+func (d *CephMgrModulesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the available manager modules",
+		Attributes: map[string]schema.Attribute{
+			"modules": schema.ListNestedAttribute{
+				MarkdownDescription: "List of manager modules",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The module name",
+							Computed:            true,
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the module is enabled",
+							Computed:            true,
+						},
+						"always_on": schema.BoolAttribute{
+							MarkdownDescription: "Whether the module is always on",
+							Computed:            true,
+						},
+						"options": schema.ListAttribute{
+							MarkdownDescription: "The names of the module options",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"enabled": schema.ListAttribute{
+				MarkdownDescription: "The names of the enabled modules, including the always-on modules",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"always_on": schema.ListAttribute{
+				MarkdownDescription: "The names of the always-on modules",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CephMgrModulesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephMgrModulesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephMgrModulesDataSourceModel
+
+	modules, err := d.client.ListMgrModules()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list manager modules: %s", err))
+		return
+	}
+
+	enabled := []string{}
+	alwaysOn := []string{}
+	data.Modules = []CephMgrModuleDataSourceModel{}
+	for _, module := range modules {
+		if module.Enabled || module.AlwaysOn {
+			enabled = append(enabled, module.Name)
+		}
+		if module.AlwaysOn {
+			alwaysOn = append(alwaysOn, module.Name)
+		}
+
+		options := make([]string, 0, len(module.Options))
+		for name := range module.Options {
+			options = append(options, name)
+		}
+		sort.Strings(options)
+		optionList, diags := types.ListValueFrom(ctx, types.StringType, options)
+		resp.Diagnostics.Append(diags...)
+
+		data.Modules = append(data.Modules, CephMgrModuleDataSourceModel{
+			Name:     types.StringValue(module.Name),
+			Enabled:  types.BoolValue(module.Enabled || module.AlwaysOn),
+			AlwaysOn: types.BoolValue(module.AlwaysOn),
+			Options:  optionList,
+		})
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.StringType, enabled)
+	resp.Diagnostics.Append(diags...)
+	data.Enabled = list
+	list, diags = types.ListValueFrom(ctx, types.StringType, alwaysOn)
+	resp.Diagnostics.Append(diags...)
+	data.AlwaysOn = list
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,155 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephOsdTreeDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephOsdTreeDataSource{}
+
+type CephOsdTreeDataSource struct {
+	client *client.Client
+}
+
+type CephOsdTreeDataSourceModel struct {
+	Nodes []CephOsdTreeNodeModel `tfsdk:"nodes"`
+}
+
+type CephOsdTreeNodeModel struct {
+	ID              types.Int64   `tfsdk:"id"`
+	Name            types.String  `tfsdk:"name"`
+	Type            types.String  `tfsdk:"type"`
+	Weight          types.Float64 `tfsdk:"weight"`
+	Parent          types.String  `tfsdk:"parent"`
+	Children        types.List    `tfsdk:"children"`
+	DeviceClass     types.String  `tfsdk:"device_class"`
+	Status          types.String  `tfsdk:"status"`
+	Reweight        types.Float64 `tfsdk:"reweight"`
+	PrimaryAffinity types.Float64 `tfsdk:"primary_affinity"`
+}
+
+func NewCephOsdTreeDataSource() datasource.DataSource {
+	return &CephOsdTreeDataSource{}
+}
+
+func (d *CephOsdTreeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_osd_tree"
+}
+
+func (d *CephOsdTreeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the OSD tree (CRUSH buckets and OSDs), equivalent to `ceph osd tree`",
+		Attributes: map[string]schema.Attribute{
+			"nodes": schema.ListNestedAttribute{
+				MarkdownDescription: "List of nodes in the OSD tree, buckets and OSDs",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "The node ID (negative for buckets, the OSD number for OSDs)",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The node name (e.g., default, host1, osd.0)",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The node type (e.g., root, rack, host, osd)",
+							Computed:            true,
+						},
+						"weight": schema.Float64Attribute{
+							MarkdownDescription: "The CRUSH weight of the node",
+							Computed:            true,
+						},
+						"parent": schema.StringAttribute{
+							MarkdownDescription: "The name of the parent bucket, empty for roots",
+							Computed:            true,
+						},
+						"children": schema.ListAttribute{
+							MarkdownDescription: "The names of the direct children, empty for OSDs",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"device_class": schema.StringAttribute{
+							MarkdownDescription: "The device class of the OSD, empty for buckets",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The OSD status (up or down), empty for buckets",
+							Computed:            true,
+						},
+						"reweight": schema.Float64Attribute{
+							MarkdownDescription: "The OSD reweight value (0 means out), 0 for buckets",
+							Computed:            true,
+						},
+						"primary_affinity": schema.Float64Attribute{
+							MarkdownDescription: "The OSD primary affinity, 0 for buckets",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CephOsdTreeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephOsdTreeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephOsdTreeDataSourceModel
+
+	nodes, err := d.client.GetCrushTree()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD tree: %s", err))
+		return
+	}
+
+	tree := newCrushTree(nodes)
+
+	data.Nodes = []CephOsdTreeNodeModel{}
+	for _, id := range tree.order {
+		node := tree.nodes[id]
+
+		children, diags := types.ListValueFrom(ctx, types.StringType, tree.childNames(node.ID))
+		resp.Diagnostics.Append(diags...)
+
+		data.Nodes = append(data.Nodes, CephOsdTreeNodeModel{
+			ID:              types.Int64Value(int64(node.ID)),
+			Name:            types.StringValue(node.Name),
+			Type:            types.StringValue(node.Type),
+			Weight:          types.Float64Value(tree.weight(node.ID)),
+			Parent:          types.StringValue(tree.parentName(node.ID)),
+			Children:        children,
+			DeviceClass:     types.StringValue(node.DeviceClass),
+			Status:          types.StringValue(node.Status),
+			Reweight:        types.Float64Value(node.Reweight),
+			PrimaryAffinity: types.Float64Value(node.PrimaryAffinity),
+		})
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -18,15 +18,21 @@ type CephPoolDataSource struct {
 }
 
 type CephPoolDataSourceModel struct {
-	Name                types.String `tfsdk:"name"`
-	PgNum               types.Int64  `tfsdk:"pg_num"`
-	Type                types.String `tfsdk:"type"`
-	PgAutoscaleMode     types.String `tfsdk:"pg_autoscale_mode"`
-	Size                types.Int64  `tfsdk:"size"`
-	RuleName            types.String `tfsdk:"rule_name"`
-	QuotaMaxBytes       types.Int64  `tfsdk:"quota_max_bytes"`
-	ApplicationMetadata types.List   `tfsdk:"application_metadata"`
-	RbdMirroring        types.Bool   `tfsdk:"rbd_mirroring"`
+	Name                types.String  `tfsdk:"name"`
+	PgNum               types.Int64   `tfsdk:"pg_num"`
+	Type                types.String  `tfsdk:"type"`
+	PgAutoscaleMode     types.String  `tfsdk:"pg_autoscale_mode"`
+	Size                types.Int64   `tfsdk:"size"`
+	RuleName            types.String  `tfsdk:"rule_name"`
+	QuotaMaxBytes       types.Int64   `tfsdk:"quota_max_bytes"`
+	QuotaMaxObjects     types.Int64   `tfsdk:"quota_max_objects"`
+	ApplicationMetadata types.List    `tfsdk:"application_metadata"`
+	RbdMirroring        types.Bool    `tfsdk:"rbd_mirroring"`
+	StoredBytes         types.Int64   `tfsdk:"stored_bytes"`
+	UsedBytes           types.Int64   `tfsdk:"used_bytes"`
+	Objects             types.Int64   `tfsdk:"objects"`
+	MaxAvailBytes       types.Int64   `tfsdk:"max_avail_bytes"`
+	UsedRatio           types.Float64 `tfsdk:"used_ratio"`
 }
 
 func NewCephPoolDataSource() datasource.DataSource {
@@ -61,12 +67,36 @@ func (d *CephPoolDataSource) Schema(ctx context.Context, req datasource.SchemaRe
 			"quota_max_bytes": schema.Int64Attribute{
 				Computed: true,
 			},
+			"quota_max_objects": schema.Int64Attribute{
+				Computed: true,
+			},
 			"application_metadata": schema.ListAttribute{
 				ElementType: types.StringType,
 				Computed:    true,
 			},
 			"rbd_mirroring": schema.BoolAttribute{
-				Computed: true,
+				Computed:           true,
+				DeprecationMessage: "This attribute does not reflect the mirroring mode. Use the ceph_rbd_mirroring data source instead.",
+			},
+			"stored_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The bytes stored in the pool by clients, before replication or erasure coding",
+				Computed:            true,
+			},
+			"used_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The raw bytes used by the pool, including replication or erasure coding",
+				Computed:            true,
+			},
+			"objects": schema.Int64Attribute{
+				MarkdownDescription: "The number of objects in the pool",
+				Computed:            true,
+			},
+			"max_avail_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The bytes that can still be stored in the pool",
+				Computed:            true,
+			},
+			"used_ratio": schema.Float64Attribute{
+				MarkdownDescription: "The used fraction (0 to 1) of the pool capacity",
+				Computed:            true,
 			},
 		},
 	}
@@ -107,6 +137,12 @@ func (d *CephPoolDataSource) Read(ctx context.Context, req datasource.ReadReques
 	data.PgAutoscaleMode = types.StringValue(pool.PgAutoscaleMode)
 	data.Size = types.Int64Value(int64(pool.Size))
 	data.QuotaMaxBytes = types.Int64Value(pool.QuotaMaxBytes)
+	data.QuotaMaxObjects = types.Int64Value(pool.QuotaMaxObjects)
+	data.StoredBytes = types.Int64Value(int64(pool.Stats.Stored.Latest))
+	data.UsedBytes = types.Int64Value(int64(pool.Stats.BytesUsed.Latest))
+	data.Objects = types.Int64Value(int64(pool.Stats.Objects.Latest))
+	data.MaxAvailBytes = types.Int64Value(int64(pool.Stats.MaxAvail.Latest))
+	data.UsedRatio = types.Float64Value(pool.Stats.PercentUsed.Latest)
 	// Note: RuleName, RbdMirroring, ApplicationMetadata mapping logic should be consistent with Resource Read.
 	// For now, we leave them null/unknown if not returned by GetPool or if mapping is complex.
 	// Assuming GetPool populates what it can.
@@ -0,0 +1,225 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephPoolsDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephPoolsDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &CephPoolsDataSource{}
+
+type CephPoolsDataSource struct {
+	client *client.Client
+}
+
+type CephPoolsDataSourceModel struct {
+	Application types.String         `tfsdk:"application"`
+	NameRegex   types.String         `tfsdk:"name_regex"`
+	Type        types.String         `tfsdk:"type"`
+	Names       types.List           `tfsdk:"names"`
+	Pools       []CephPoolsPoolModel `tfsdk:"pools"`
+}
+
+type CephPoolsPoolModel struct {
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	Size                types.Int64  `tfsdk:"size"`
+	PgNum               types.Int64  `tfsdk:"pg_num"`
+	PgAutoscaleMode     types.String `tfsdk:"pg_autoscale_mode"`
+	RuleName            types.String `tfsdk:"rule_name"`
+	ApplicationMetadata types.List   `tfsdk:"application_metadata"`
+	QuotaMaxBytes       types.Int64  `tfsdk:"quota_max_bytes"`
+	QuotaMaxObjects     types.Int64  `tfsdk:"quota_max_objects"`
+}
+
+// poolTypes lists the types of pools
+var poolTypes = []string{"replicated", "erasure"}
+
+func NewCephPoolsDataSource() datasource.DataSource {
+	return &CephPoolsDataSource{}
+}
+
+func (d *CephPoolsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_pools"
+}
+
+func (d *CephPoolsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the pools of the cluster, optionally filtered by application, name or type",
+		Attributes: map[string]schema.Attribute{
+			"application": schema.StringAttribute{
+				MarkdownDescription: "Only list the pools with this application enabled (e.g., rbd, cephfs, rgw)",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list the pools whose name matches this regular expression (RE2 syntax)",
+				Optional:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Only list the pools of this type (replicated or erasure)",
+				Optional:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "The names of the listed pools",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"pools": schema.ListNestedAttribute{
+				MarkdownDescription: "List of pools",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the pool",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the pool (replicated or erasure)",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "The number of replicas, or data and coding chunks",
+							Computed:            true,
+						},
+						"pg_num": schema.Int64Attribute{
+							MarkdownDescription: "The number of placement groups",
+							Computed:            true,
+						},
+						"pg_autoscale_mode": schema.StringAttribute{
+							MarkdownDescription: "The PG autoscale mode (on, off or warn)",
+							Computed:            true,
+						},
+						"rule_name": schema.StringAttribute{
+							MarkdownDescription: "The CRUSH rule of the pool",
+							Computed:            true,
+						},
+						"application_metadata": schema.ListAttribute{
+							MarkdownDescription: "The applications enabled on the pool",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"quota_max_bytes": schema.Int64Attribute{
+							MarkdownDescription: "The byte quota of the pool (0 for no quota)",
+							Computed:            true,
+						},
+						"quota_max_objects": schema.Int64Attribute{
+							MarkdownDescription: "The object quota of the pool (0 for no quota)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CephPoolsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephPoolsDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var data CephPoolsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.NameRegex.IsNull() && !data.NameRegex.IsUnknown() {
+		if _, err := regexp.Compile(data.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex",
+				fmt.Sprintf("Unable to parse the regular expression: %s", err))
+		}
+	}
+
+	if !data.Type.IsNull() && !data.Type.IsUnknown() && !slices.Contains(poolTypes, data.Type.ValueString()) {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid Pool Type",
+			fmt.Sprintf("Expected replicated or erasure, got: %s", data.Type.ValueString()))
+	}
+}
+
+func (d *CephPoolsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephPoolsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Name Regex",
+				fmt.Sprintf("Unable to parse the regular expression: %s", err))
+			return
+		}
+		nameRegex = re
+	}
+
+	pools, err := d.client.ListPools()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list pools: %s", err))
+		return
+	}
+
+	names := []string{}
+	data.Pools = []CephPoolsPoolModel{}
+	for _, pool := range pools {
+		if !data.Application.IsNull() && !slices.Contains(pool.ApplicationMetadata, data.Application.ValueString()) {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(pool.PoolName) {
+			continue
+		}
+		if !data.Type.IsNull() && pool.Type != data.Type.ValueString() {
+			continue
+		}
+
+		applications := pool.ApplicationMetadata
+		if applications == nil {
+			applications = []string{}
+		}
+		applicationList, diags := types.ListValueFrom(ctx, types.StringType, applications)
+		resp.Diagnostics.Append(diags...)
+
+		names = append(names, pool.PoolName)
+		data.Pools = append(data.Pools, CephPoolsPoolModel{
+			Name:                types.StringValue(pool.PoolName),
+			Type:                types.StringValue(pool.Type),
+			Size:                types.Int64Value(int64(pool.Size)),
+			PgNum:               types.Int64Value(int64(pool.PgNum)),
+			PgAutoscaleMode:     types.StringValue(pool.PgAutoscaleMode),
+			RuleName:            types.StringValue(pool.RuleName),
+			ApplicationMetadata: applicationList,
+			QuotaMaxBytes:       types.Int64Value(pool.QuotaMaxBytes),
+			QuotaMaxObjects:     types.Int64Value(pool.QuotaMaxObjects),
+		})
+	}
+
+	nameList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	data.Names = nameList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephRbdMirroringDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephRbdMirroringDataSource{}
+
+type CephRbdMirroringDataSource struct {
+	client *client.Client
+}
+
+type CephRbdMirroringDataSourceModel struct {
+	SiteName      types.String                  `tfsdk:"site_name"`
+	Daemons       []CephRbdMirroringDaemonModel `tfsdk:"daemons"`
+	Pools         []CephRbdMirroringPoolModel   `tfsdk:"pools"`
+	ImagesInError types.Int64                   `tfsdk:"images_in_error"`
+	ImagesSyncing types.Int64                   `tfsdk:"images_syncing"`
+	ImagesReady   types.Int64                   `tfsdk:"images_ready"`
+}
+
+type CephRbdMirroringDaemonModel struct {
+	ID       types.String `tfsdk:"id"`
+	Hostname types.String `tfsdk:"hostname"`
+	Version  types.String `tfsdk:"version"`
+	Health   types.String `tfsdk:"health"`
+}
+
+type CephRbdMirroringPoolModel struct {
+	Name      types.String `tfsdk:"name"`
+	Mode      types.String `tfsdk:"mode"`
+	Health    types.String `tfsdk:"health"`
+	PeerUUIDs types.List   `tfsdk:"peer_uuids"`
+}
+
+func NewCephRbdMirroringDataSource() datasource.DataSource {
+	return &CephRbdMirroringDataSource{}
+}
+
+func (d *CephRbdMirroringDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbd_mirroring"
+}
+
+func (d *CephRbdMirroringDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Read the RBD mirroring status of the cluster: rbd-mirror daemons, mirrored pools and image replication health",
+		Attributes: map[string]schema.Attribute{
+			"site_name": schema.StringAttribute{
+				MarkdownDescription: "The mirroring site name of the cluster",
+				Computed:            true,
+			},
+			"daemons": schema.ListNestedAttribute{
+				MarkdownDescription: "The rbd-mirror daemons",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The daemon ID",
+							Computed:            true,
+						},
+						"hostname": schema.StringAttribute{
+							MarkdownDescription: "The host running the daemon",
+							Computed:            true,
+						},
+						"version": schema.StringAttribute{
+							MarkdownDescription: "The Ceph version of the daemon",
+							Computed:            true,
+						},
+						"health": schema.StringAttribute{
+							MarkdownDescription: "The health of the daemon (e.g., OK, Warning, Error)",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"pools": schema.ListNestedAttribute{
+				MarkdownDescription: "The pools and their mirroring status",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the pool",
+							Computed:            true,
+						},
+						"mode": schema.StringAttribute{
+							MarkdownDescription: "The mirroring mode (disabled, image or pool)",
+							Computed:            true,
+						},
+						"health": schema.StringAttribute{
+							MarkdownDescription: "The mirroring health of the pool (e.g., OK, Warning, Error)",
+							Computed:            true,
+						},
+						"peer_uuids": schema.ListAttribute{
+							MarkdownDescription: "The UUIDs of the peers of the pool",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"images_in_error": schema.Int64Attribute{
+				MarkdownDescription: "The number of mirrored images in an error state",
+				Computed:            true,
+			},
+			"images_syncing": schema.Int64Attribute{
+				MarkdownDescription: "The number of mirrored images being synchronized",
+				Computed:            true,
+			},
+			"images_ready": schema.Int64Attribute{
+				MarkdownDescription: "The number of mirrored images replicating normally",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *CephRbdMirroringDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephRbdMirroringDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephRbdMirroringDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	summary, err := d.client.GetRbdMirrorSummary()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RBD mirroring summary: %s", err))
+		return
+	}
+
+	data.SiteName = types.StringValue(summary.SiteName)
+	data.ImagesInError = types.Int64Value(int64(len(summary.ContentData.ImageError)))
+	data.ImagesSyncing = types.Int64Value(int64(len(summary.ContentData.ImageSyncing)))
+	data.ImagesReady = types.Int64Value(int64(len(summary.ContentData.ImageReady)))
+
+	data.Daemons = []CephRbdMirroringDaemonModel{}
+	for _, daemon := range summary.ContentData.Daemons {
+		data.Daemons = append(data.Daemons, CephRbdMirroringDaemonModel{
+			ID:       types.StringValue(daemon.ID),
+			Hostname: types.StringValue(daemon.ServerHost),
+			Version:  types.StringValue(daemon.Version),
+			Health:   types.StringValue(daemon.Health),
+		})
+	}
+
+	data.Pools = []CephRbdMirroringPoolModel{}
+	for _, pool := range summary.ContentData.Pools {
+		peers, diags := types.ListValueFrom(ctx, types.StringType, pool.PeerUUIDs)
+		resp.Diagnostics.Append(diags...)
+		data.Pools = append(data.Pools, CephRbdMirroringPoolModel{
+			Name:      types.StringValue(pool.Name),
+			Mode:      types.StringValue(pool.MirrorMode),
+			Health:    types.StringValue(pool.Health),
+			PeerUUIDs: peers,
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/clouddicted/terraform-provider-ceph/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -83,15 +82,7 @@ func (d *CephUserDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Extract pools from capabilities (caps is now a map[string]string)
-	var pools []string
-	for service, cap := range user.Caps {
-		if service == "osd" && strings.HasPrefix(cap, "profile rbd pool=") {
-			pool := strings.TrimPrefix(cap, "profile rbd pool=")
-			pools = append(pools, pool)
-		}
-	}
-	data.Pools, _ = types.ListValueFrom(ctx, types.StringType, pools)
+	data.Pools, _ = types.ListValueFrom(ctx, types.StringType, client.UserRbdPools(user.Caps))
 
 	key, err := d.client.ExportUser(data.Name.ValueString())
 	if err != nil {
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &CephUsersDataSource{}
+var _ datasource.DataSourceWithConfigure = &CephUsersDataSource{}
+
+type CephUsersDataSource struct {
+	client *client.Client
+}
+
+type CephUsersDataSourceModel struct {
+	EntityPrefix types.String         `tfsdk:"entity_prefix"`
+	Names        types.List           `tfsdk:"names"`
+	Users        []CephUsersUserModel `tfsdk:"users"`
+}
+
+type CephUsersUserModel struct {
+	Name  types.String `tfsdk:"name"`
+	Caps  types.Map    `tfsdk:"caps"`
+	Pools types.List   `tfsdk:"pools"`
+}
+
+func NewCephUsersDataSource() datasource.DataSource {
+	return &CephUsersDataSource{}
+}
+
+func (d *CephUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *CephUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "List the users (cephx entities) of the cluster with their capabilities, optionally filtered by entity prefix. Keys are not listed; use the `ceph_user` data source to read the key of a user",
+		Attributes: map[string]schema.Attribute{
+			"entity_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list the users whose entity name starts with this prefix (e.g., client. or client.csi-)",
+				Optional:            true,
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "The entity names of the listed users",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "List of users",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The user entity name (e.g., client.app)",
+							Computed:            true,
+						},
+						"caps": schema.MapAttribute{
+							MarkdownDescription: "The capabilities of the user, keyed by daemon type (mon, osd, mds, mgr)",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+						"pools": schema.ListAttribute{
+							MarkdownDescription: "List of pool names the user can access with the rbd profile",
+							ElementType:         types.StringType,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CephUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *CephUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data CephUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	users, err := d.client.ListUsers()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users: %s", err))
+		return
+	}
+
+	names := []string{}
+	data.Users = []CephUsersUserModel{}
+	for _, user := range users {
+		if !data.EntityPrefix.IsNull() && !strings.HasPrefix(user.Entity, data.EntityPrefix.ValueString()) {
+			continue
+		}
+
+		caps := user.Caps
+		if caps == nil {
+			caps = map[string]string{}
+		}
+		capMap, diags := types.MapValueFrom(ctx, types.StringType, caps)
+		resp.Diagnostics.Append(diags...)
+		poolList, diags := types.ListValueFrom(ctx, types.StringType, client.UserRbdPools(user.Caps))
+		resp.Diagnostics.Append(diags...)
+
+		names = append(names, user.Entity)
+		data.Users = append(data.Users, CephUsersUserModel{
+			Name:  types.StringValue(user.Entity),
+			Caps:  capMap,
+			Pools: poolList,
+		})
+	}
+
+	nameList, diags := types.ListValueFrom(ctx, types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	data.Names = nameList
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
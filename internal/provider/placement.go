@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// CephPlacementModel describes an orchestrator placement specification
+type CephPlacementModel struct {
+	Count types.Int64  `tfsdk:"count"`
+	Hosts types.List   `tfsdk:"hosts"`
+	Label types.String `tfsdk:"label"`
+}
+
+// placementAttribute returns the schema of an orchestrator placement block
+func placementAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"count": schema.Int64Attribute{
+				MarkdownDescription: "The number of daemons to deploy",
+				Optional:            true,
+			},
+			"hosts": schema.ListAttribute{
+				MarkdownDescription: "The hosts to deploy daemons on",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "Deploy daemons on hosts with this label",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// expandPlacement converts the placement model into a client placement
+func expandPlacement(ctx context.Context, placement *CephPlacementModel) (client.ServicePlacement, diag.Diagnostics) {
+	var result client.ServicePlacement
+	var diags diag.Diagnostics
+	if placement == nil {
+		return result, diags
+	}
+
+	result.Count = int(placement.Count.ValueInt64())
+	result.Label = placement.Label.ValueString()
+	if !placement.Hosts.IsNull() {
+		diags.Append(placement.Hosts.ElementsAs(ctx, &result.Hosts, false)...)
+	}
+
+	return result, diags
+}
+
+// flattenPlacement converts a client placement into the placement model, where an empty placement is nil
+func flattenPlacement(ctx context.Context, placement client.ServicePlacement) (*CephPlacementModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if placement.Count == 0 && len(placement.Hosts) == 0 && placement.Label == "" {
+		return nil, diags
+	}
+
+	result := &CephPlacementModel{
+		Count: types.Int64Null(),
+		Hosts: types.ListNull(types.StringType),
+		Label: types.StringNull(),
+	}
+	if placement.Count > 0 {
+		result.Count = types.Int64Value(int64(placement.Count))
+	}
+	if len(placement.Hosts) > 0 {
+		hosts, d := types.ListValueFrom(ctx, types.StringType, placement.Hosts)
+		diags.Append(d...)
+		result.Hosts = hosts
+	}
+	if placement.Label != "" {
+		result.Label = types.StringValue(placement.Label)
+	}
+
+	return result, diags
+}
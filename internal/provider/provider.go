@@ -28,6 +28,9 @@ type CephProviderModel struct {
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
 	Insecure types.Bool   `tfsdk:"insecure"`
+
+	RequireHealthy      types.Bool `tfsdk:"require_healthy"`
+	AllowedHealthChecks types.List `tfsdk:"allowed_health_checks"`
 }
 
 func (p *CephProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -55,6 +58,17 @@ func (p *CephProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				MarkdownDescription: "Whether to skip TLS verification. Default: false.",
 				Optional:            true,
 			},
+			"require_healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether to refuse changes to the cluster unless its health is HEALTH_OK " +
+					"or all raised health checks are muted or listed in `allowed_health_checks`. " +
+					"The health is checked once, before the first change. Default: false.",
+				Optional: true,
+			},
+			"allowed_health_checks": schema.ListAttribute{
+				MarkdownDescription: "The health checks that do not block changes when `require_healthy` is set (e.g., OSDMAP_FLAGS, RECENT_CRASH)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -79,6 +93,17 @@ func (p *CephProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	if data.RequireHealthy.ValueBool() {
+		gate := &client.HealthGate{}
+		if !data.AllowedHealthChecks.IsNull() {
+			resp.Diagnostics.Append(data.AllowedHealthChecks.ElementsAs(ctx, &gate.AllowedChecks, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		c.HealthGate = gate
+	}
+
 	resp.DataSourceData = c
 	resp.ResourceData = c
 }
@@ -88,6 +113,36 @@ func (p *CephProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewCephPoolResource,
 		NewCephUserResource,
 		NewCephCrushRuleResource,
+		NewCephCrushBucketResource,
+		NewCephOsdDeviceClassResource,
+		NewCephCephFSVolumeResource,
+		NewCephCephFSSubvolumeGroupResource,
+		NewCephCephFSSubvolumeResource,
+		NewCephCephFSClientResource,
+		NewCephCephFSSnapshotScheduleResource,
+		NewCephCephFSQuotaResource,
+		NewCephRGWUserResource,
+		NewCephRGWUserKeyResource,
+		NewCephRGWBucketResource,
+		NewCephRGWRealmResource,
+		NewCephRGWZonegroupResource,
+		NewCephRGWZoneResource,
+		NewCephRbdMirrorSiteNameResource,
+		NewCephRbdMirrorPoolResource,
+		NewCephRbdMirrorBootstrapTokenResource,
+		NewCephRbdMirrorPeerResource,
+		NewCephRbdImageMirroringResource,
+		NewCephNFSExportResource,
+		NewCephOrchServiceResource,
+		NewCephHostResource,
+		NewCephOsdSpecResource,
+		NewCephOsdResource,
+		NewCephOsdFlagsResource,
+		NewCephOsdSettingsResource,
+		NewCephConfigResource,
+		NewCephMgrModuleResource,
+		NewCephDashboardUserResource,
+		NewCephDashboardRoleResource,
 	}
 }
 
@@ -98,6 +153,18 @@ func (p *CephProvider) DataSources(ctx context.Context) []func() datasource.Data
 		NewCephClusterDataSource,
 		NewCephMonitorsDataSource,
 		NewCephCrushRuleDataSource,
+		NewCephCrushRulesDataSource,
+		NewCephCrushMapDataSource,
+		NewCephOsdTreeDataSource,
+		NewCephCephFSDataSource,
+		NewCephRbdMirroringDataSource,
+		NewCephHostsDataSource,
+		NewCephConfigOptionDataSource,
+		NewCephMgrModulesDataSource,
+		NewCephHealthDataSource,
+		NewCephDfDataSource,
+		NewCephPoolsDataSource,
+		NewCephUsersDataSource,
 	}
 }
 
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephCephFSClientResource{}
+var _ resource.ResourceWithConfigure = &CephCephFSClientResource{}
+var _ resource.ResourceWithImportState = &CephCephFSClientResource{}
+var _ resource.ResourceWithValidateConfig = &CephCephFSClientResource{}
+
+// cephfsPermissions lists the permissions accepted by "ceph fs authorize"
+var cephfsPermissions = map[string]bool{"r": true, "rw": true, "rwp": true, "rws": true, "rwps": true}
+
+type CephCephFSClientResource struct {
+	client *client.Client
+}
+
+type CephCephFSClientResourceModel struct {
+	Name   types.String           `tfsdk:"name"`
+	FsName types.String           `tfsdk:"fs_name"`
+	Paths  []CephCephFSClientPath `tfsdk:"paths"`
+	Caps   types.Map              `tfsdk:"caps"`
+	Key    types.String           `tfsdk:"key"`
+}
+
+type CephCephFSClientPath struct {
+	Path       types.String `tfsdk:"path"`
+	Permission types.String `tfsdk:"permission"`
+	RootSquash types.Bool   `tfsdk:"root_squash"`
+}
+
+func NewCephCephFSClientResource() resource.Resource {
+	return &CephCephFSClientResource{}
+}
+
+func (r *CephCephFSClientResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs_client"
+}
+
+func (r *CephCephFSClientResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Ceph user authorized to mount CephFS paths, equivalent to `ceph fs authorize`",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The user entity name (e.g., client.myapp)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fs_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the file system to authorize access to",
+				Required:            true,
+			},
+			"paths": schema.ListNestedAttribute{
+				MarkdownDescription: "The paths the user can access",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							MarkdownDescription: "The path within the file system (e.g., /volumes/csi). Use / for the whole file system.",
+							Required:            true,
+						},
+						"permission": schema.StringAttribute{
+							MarkdownDescription: "The permission: r, rw, rwp (layouts and quotas), rws (snapshots) or rwps. Default: rw.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString("rw"),
+						},
+						"root_squash": schema.BoolAttribute{
+							MarkdownDescription: "Whether to squash the root user to an unprivileged user on this path. Default: false.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+						},
+					},
+				},
+			},
+			"caps": schema.MapAttribute{
+				MarkdownDescription: "The generated capabilities by entity (mon, mds, osd)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"key": schema.StringAttribute{
+				MarkdownDescription: "The exported keyring/key for the user",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *CephCephFSClientResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephCephFSClientResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, p := range data.Paths {
+		if p.Permission.IsNull() || p.Permission.IsUnknown() {
+			continue
+		}
+		if !cephfsPermissions[p.Permission.ValueString()] {
+			resp.Diagnostics.AddAttributeError(path.Root("paths").AtListIndex(i).AtName("permission"), "Invalid CephFS Permission",
+				fmt.Sprintf("permission must be one of r, rw, rwp, rws or rwps, got: %s", p.Permission.ValueString()))
+		}
+	}
+}
+
+func (r *CephCephFSClientResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// buildCaps generates the capabilities for the planned paths
+func (r *CephCephFSClientResource) buildCaps(data *CephCephFSClientResourceModel) []client.Capability {
+	var paths []client.CephFSPathAccess
+	for _, p := range data.Paths {
+		paths = append(paths, client.CephFSPathAccess{
+			Path:       p.Path.ValueString(),
+			Permission: p.Permission.ValueString(),
+			RootSquash: p.RootSquash.ValueBool(),
+		})
+	}
+	return client.BuildCephFSCapabilities(data.FsName.ValueString(), paths)
+}
+
+// refresh reads the user capabilities and key back into the model
+func (r *CephCephFSClientResource) refresh(ctx context.Context, data *CephCephFSClientResourceModel) error {
+	user, err := r.client.GetUser(data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+
+	// Reconstruct the paths from the MDS capability so that changes made
+	// outside of Terraform show up in the plan.
+	fsName, paths, err := client.ParseCephFSCapabilities(user.Caps["mds"])
+	if err != nil {
+		return fmt.Errorf("unable to parse the mds capability of %s: %w", data.Name.ValueString(), err)
+	}
+	data.FsName = types.StringValue(fsName)
+	data.Paths = nil
+	for _, p := range paths {
+		data.Paths = append(data.Paths, CephCephFSClientPath{
+			Path:       types.StringValue(p.Path),
+			Permission: types.StringValue(p.Permission),
+			RootSquash: types.BoolValue(p.RootSquash),
+		})
+	}
+
+	caps, diags := types.MapValueFrom(ctx, types.StringType, user.Caps)
+	if diags.HasError() {
+		return fmt.Errorf("unable to convert capabilities")
+	}
+	data.Caps = caps
+
+	key, err := r.client.ExportUser(data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+	data.Key = types.StringValue(key)
+
+	return nil
+}
+
+func (r *CephCephFSClientResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephCephFSClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateUserWithCapabilities(data.Name.ValueString(), r.buildCaps(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create user: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSClientResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephCephFSClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSClientResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephCephFSClientResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateUserWithCapabilities(data.Name.ValueString(), r.buildCaps(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSClientResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephCephFSClientResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteUser(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete user: %s", err))
+		return
+	}
+}
+
+func (r *CephCephFSClientResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
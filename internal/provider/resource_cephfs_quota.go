@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephCephFSQuotaResource{}
+var _ resource.ResourceWithConfigure = &CephCephFSQuotaResource{}
+var _ resource.ResourceWithImportState = &CephCephFSQuotaResource{}
+
+type CephCephFSQuotaResource struct {
+	client *client.Client
+}
+
+type CephCephFSQuotaResourceModel struct {
+	FsName   types.String `tfsdk:"fs_name"`
+	Path     types.String `tfsdk:"path"`
+	MaxBytes types.Int64  `tfsdk:"max_bytes"`
+	MaxFiles types.Int64  `tfsdk:"max_files"`
+}
+
+func NewCephCephFSQuotaResource() resource.Resource {
+	return &CephCephFSQuotaResource{}
+}
+
+func (r *CephCephFSQuotaResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs_quota"
+}
+
+func (r *CephCephFSQuotaResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the quotas of a CephFS directory. Destroying the resource removes the quotas.",
+		Attributes: map[string]schema.Attribute{
+			"fs_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the CephFS file system",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The absolute path of the directory (e.g., /shares/projects)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_bytes": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of bytes stored below the directory. 0 means no limit. Default: 0.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+			"max_files": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of files and directories below the directory. 0 means no limit. Default: 0.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+			},
+		},
+	}
+}
+
+func (r *CephCephFSQuotaResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// setQuotas applies the given quotas to the directory, resolving the file system ID by name
+func (r *CephCephFSQuotaResource) setQuotas(data *CephCephFSQuotaResourceModel, quotas client.CephFSQuotas) error {
+	fs, err := r.client.GetCephFS(data.FsName.ValueString())
+	if err != nil {
+		return err
+	}
+
+	return r.client.SetCephFSQuotas(fs.ID, data.Path.ValueString(), quotas)
+}
+
+func (r *CephCephFSQuotaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephCephFSQuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setQuotas(&data, client.CephFSQuotas{
+		MaxBytes: data.MaxBytes.ValueInt64(),
+		MaxFiles: data.MaxFiles.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set quotas: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSQuotaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephCephFSQuotaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fs, err := r.client.GetCephFS(data.FsName.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read file system: %s", err))
+		return
+	}
+
+	quotas, err := r.client.GetCephFSQuotas(fs.ID, data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read quotas: %s", err))
+		return
+	}
+
+	data.MaxBytes = types.Int64Value(quotas.MaxBytes)
+	data.MaxFiles = types.Int64Value(quotas.MaxFiles)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSQuotaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephCephFSQuotaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setQuotas(&data, client.CephFSQuotas{
+		MaxBytes: data.MaxBytes.ValueInt64(),
+		MaxFiles: data.MaxFiles.ValueInt64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set quotas: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSQuotaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephCephFSQuotaResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Removing a quota is done by setting it to 0
+	err := r.setQuotas(&data, client.CephFSQuotas{})
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove quotas: %s", err))
+		return
+	}
+}
+
+func (r *CephCephFSQuotaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	fsName, dir, ok := strings.Cut(req.ID, ":")
+	if !ok || fsName == "" || dir == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <fs_name>:<path>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fs_name"), fsName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), dir)...)
+}
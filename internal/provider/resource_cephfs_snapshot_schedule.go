@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephCephFSSnapshotScheduleResource{}
+var _ resource.ResourceWithConfigure = &CephCephFSSnapshotScheduleResource{}
+var _ resource.ResourceWithImportState = &CephCephFSSnapshotScheduleResource{}
+var _ resource.ResourceWithValidateConfig = &CephCephFSSnapshotScheduleResource{}
+
+type CephCephFSSnapshotScheduleResource struct {
+	client *client.Client
+}
+
+type CephCephFSSnapshotScheduleResourceModel struct {
+	FsName    types.String `tfsdk:"fs_name"`
+	Path      types.String `tfsdk:"path"`
+	Schedule  types.String `tfsdk:"schedule"`
+	Start     types.String `tfsdk:"start"`
+	Retention types.Map    `tfsdk:"retention"`
+	Active    types.Bool   `tfsdk:"active"`
+}
+
+func NewCephCephFSSnapshotScheduleResource() resource.Resource {
+	return &CephCephFSSnapshotScheduleResource{}
+}
+
+func (r *CephCephFSSnapshotScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs_snapshot_schedule"
+}
+
+func (r *CephCephFSSnapshotScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a snapshot schedule on a CephFS path, using the `snap_schedule` manager module",
+		Attributes: map[string]schema.Attribute{
+			"fs_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the CephFS file system",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The absolute path of the directory to snapshot (e.g., /volumes/csi)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "The snapshot interval: a number followed by m (minutes), h, d, w, M (months) or y (e.g., 1h)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "The time of the first snapshot in ISO 8601 format (e.g., 2024-01-01T00:00:00). Defaults to the creation time.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"retention": schema.MapAttribute{
+				MarkdownDescription: "The number of snapshots to keep per period, keyed by m, h, d, w, M, y or n (the last n snapshots) (e.g., { h = 24, d = 7 }). " +
+					"The retention policy applies to all schedules on the path. Can be changed in place.",
+				ElementType: types.Int64Type,
+				Optional:    true,
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the schedule is active",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CephCephFSSnapshotScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephCephFSSnapshotScheduleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephCephFSSnapshotScheduleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Schedule.IsUnknown() || data.Retention.IsUnknown() {
+		return
+	}
+
+	var retention map[string]int
+	resp.Diagnostics.Append(data.Retention.ElementsAs(ctx, &retention, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := client.ValidateSnapshotSchedule(data.Schedule.ValueString(), retention); err != nil {
+		resp.Diagnostics.AddError("Invalid Snapshot Schedule", err.Error())
+	}
+
+	if !data.Path.IsUnknown() && !strings.HasPrefix(data.Path.ValueString(), "/") {
+		resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid Path", "The path must be absolute.")
+	}
+}
+
+// retentionMap converts the retention attribute into the map expected by the client
+func retentionMap(ctx context.Context, retention types.Map) (map[string]int, error) {
+	result := map[string]int{}
+	if retention.IsNull() || retention.IsUnknown() {
+		return result, nil
+	}
+
+	diags := retention.ElementsAs(ctx, &result, false)
+	if diags.HasError() {
+		return nil, fmt.Errorf("unable to read retention: %v", diags)
+	}
+	return result, nil
+}
+
+func (r *CephCephFSSnapshotScheduleResource) refresh(ctx context.Context, data *CephCephFSSnapshotScheduleResourceModel) error {
+	schedule, err := r.client.GetCephFSSnapshotSchedule(data.FsName.ValueString(), data.Path.ValueString(), data.Schedule.ValueString())
+	if err != nil {
+		return err
+	}
+
+	// Keep the configured spelling of the start time, the API normalizes it
+	if data.Start.IsNull() || data.Start.IsUnknown() {
+		data.Start = types.StringValue(schedule.Start)
+	}
+	data.Active = types.BoolValue(schedule.Active)
+
+	if len(schedule.Retention) == 0 {
+		data.Retention = types.MapNull(types.Int64Type)
+	} else {
+		elements := make(map[string]int64, len(schedule.Retention))
+		for period, count := range schedule.Retention {
+			elements[period] = int64(count)
+		}
+		retention, diags := types.MapValueFrom(ctx, types.Int64Type, elements)
+		if diags.HasError() {
+			return fmt.Errorf("unable to set retention: %v", diags)
+		}
+		data.Retention = retention
+	}
+
+	return nil
+}
+
+func (r *CephCephFSSnapshotScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephCephFSSnapshotScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retention, err := retentionMap(ctx, data.Retention)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Retention", err.Error())
+		return
+	}
+
+	schedule := client.CephFSSnapshotSchedule{
+		Fs:        data.FsName.ValueString(),
+		Path:      data.Path.ValueString(),
+		Schedule:  data.Schedule.ValueString(),
+		Retention: retention,
+	}
+	if !data.Start.IsUnknown() {
+		schedule.Start = data.Start.ValueString()
+	}
+
+	err = r.client.CreateCephFSSnapshotSchedule(schedule)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create snapshot schedule: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created snapshot schedule: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSSnapshotScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephCephFSSnapshotScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read snapshot schedule: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSSnapshotScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CephCephFSSnapshotScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the retention can change in place
+	oldRetention, err := retentionMap(ctx, state.Retention)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Retention", err.Error())
+		return
+	}
+	newRetention, err := retentionMap(ctx, plan.Retention)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Retention", err.Error())
+		return
+	}
+
+	err = r.client.UpdateCephFSSnapshotRetention(plan.FsName.ValueString(), plan.Path.ValueString(), oldRetention, newRetention)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update snapshot retention: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated snapshot schedule: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CephCephFSSnapshotScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephCephFSSnapshotScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	retention, err := retentionMap(ctx, data.Retention)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Retention", err.Error())
+		return
+	}
+
+	err = r.client.DeleteCephFSSnapshotSchedule(client.CephFSSnapshotSchedule{
+		Fs:        data.FsName.ValueString(),
+		Path:      data.Path.ValueString(),
+		Schedule:  data.Schedule.ValueString(),
+		Start:     data.Start.ValueString(),
+		Retention: retention,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete snapshot schedule: %s", err))
+		return
+	}
+}
+
+func (r *CephCephFSSnapshotScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, ":", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <fs_name>:<path>:<schedule>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("fs_name"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("path"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("schedule"), parts[2])...)
+}
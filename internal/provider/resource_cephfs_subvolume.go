@@ -0,0 +1,233 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephCephFSSubvolumeResource{}
+var _ resource.ResourceWithConfigure = &CephCephFSSubvolumeResource{}
+var _ resource.ResourceWithImportState = &CephCephFSSubvolumeResource{}
+
+type CephCephFSSubvolumeResource struct {
+	client *client.Client
+}
+
+type CephCephFSSubvolumeResourceModel struct {
+	Volume            types.String `tfsdk:"volume"`
+	Group             types.String `tfsdk:"group"`
+	Name              types.String `tfsdk:"name"`
+	Size              types.Int64  `tfsdk:"size"`
+	Mode              types.String `tfsdk:"mode"`
+	UID               types.Int64  `tfsdk:"uid"`
+	GID               types.Int64  `tfsdk:"gid"`
+	PoolLayout        types.String `tfsdk:"pool_layout"`
+	NamespaceIsolated types.Bool   `tfsdk:"namespace_isolated"`
+	PoolNamespace     types.String `tfsdk:"pool_namespace"`
+	Path              types.String `tfsdk:"path"`
+}
+
+func NewCephCephFSSubvolumeResource() resource.Resource {
+	return &CephCephFSSubvolumeResource{}
+}
+
+func (r *CephCephFSSubvolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs_subvolume"
+}
+
+func (r *CephCephFSSubvolumeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := subvolumeAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the subvolume",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["group"] = schema.StringAttribute{
+		MarkdownDescription: "The subvolume group. Unset places the subvolume in the default group.",
+		Optional:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["namespace_isolated"] = schema.BoolAttribute{
+		MarkdownDescription: "Whether to store the subvolume data in a separate RADOS namespace. Default: false.",
+		Optional:            true,
+		Computed:            true,
+		Default:             booldefault.StaticBool(false),
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.RequiresReplace(),
+		},
+	}
+	attributes["pool_namespace"] = schema.StringAttribute{
+		MarkdownDescription: "The RADOS namespace the subvolume data is stored in",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	attributes["path"] = schema.StringAttribute{
+		MarkdownDescription: "The absolute path of the subvolume in the file system, e.g. for `rootPath` in static ceph-csi volumes",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a CephFS subvolume",
+		Attributes:          attributes,
+	}
+}
+
+func (r *CephCephFSSubvolumeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephCephFSSubvolumeResource) refresh(data *CephCephFSSubvolumeResourceModel) error {
+	info, err := r.client.GetCephFSSubvolume(data.Volume.ValueString(), data.Group.ValueString(), data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Size = quotaToState(info.BytesQuota)
+	data.Mode = modeToState(data.Mode, info)
+	data.UID = types.Int64Value(info.UID)
+	data.GID = types.Int64Value(info.GID)
+	data.PoolLayout = types.StringValue(info.DataPool)
+	data.PoolNamespace = types.StringValue(info.PoolNamespace)
+	data.Path = types.StringValue(info.Path)
+	if data.NamespaceIsolated.IsNull() {
+		data.NamespaceIsolated = types.BoolValue(info.PoolNamespace != "")
+	}
+
+	return nil
+}
+
+func (r *CephCephFSSubvolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephCephFSSubvolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subvolume := client.CephFSSubvolumeRequest{
+		VolName:        data.Volume.ValueString(),
+		SubvolName:     data.Name.ValueString(),
+		GroupName:      data.Group.ValueString(),
+		IsolatedNspace: data.NamespaceIsolated.ValueBool(),
+	}
+	expandSubvolumeRequest(&subvolume, data.Size, data.UID, data.GID, data.Mode, data.PoolLayout)
+
+	err := r.client.CreateCephFSSubvolume(subvolume)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create subvolume: %s", err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created subvolume: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSSubvolumeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephCephFSSubvolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(&data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subvolume: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSSubvolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephCephFSSubvolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the size can change in place
+	err := r.client.ResizeCephFSSubvolume(data.Volume.ValueString(), data.Group.ValueString(), data.Name.ValueString(), data.Size.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resize subvolume: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSSubvolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephCephFSSubvolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCephFSSubvolume(data.Volume.ValueString(), data.Group.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subvolume: %s", err))
+		return
+	}
+}
+
+func (r *CephCephFSSubvolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	var volume, group, name string
+	switch len(parts) {
+	case 2:
+		volume, name = parts[0], parts[1]
+	case 3:
+		volume, group, name = parts[0], parts[1], parts[2]
+	}
+	if volume == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <volume>:<subvolume> or <volume>:<group>:<subvolume>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volume)...)
+	if group != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group"), group)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephCephFSSubvolumeGroupResource{}
+var _ resource.ResourceWithConfigure = &CephCephFSSubvolumeGroupResource{}
+var _ resource.ResourceWithImportState = &CephCephFSSubvolumeGroupResource{}
+
+type CephCephFSSubvolumeGroupResource struct {
+	client *client.Client
+}
+
+type CephCephFSSubvolumeGroupResourceModel struct {
+	Volume     types.String `tfsdk:"volume"`
+	Name       types.String `tfsdk:"name"`
+	Size       types.Int64  `tfsdk:"size"`
+	Mode       types.String `tfsdk:"mode"`
+	UID        types.Int64  `tfsdk:"uid"`
+	GID        types.Int64  `tfsdk:"gid"`
+	PoolLayout types.String `tfsdk:"pool_layout"`
+}
+
+func NewCephCephFSSubvolumeGroupResource() resource.Resource {
+	return &CephCephFSSubvolumeGroupResource{}
+}
+
+func (r *CephCephFSSubvolumeGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs_subvolume_group"
+}
+
+// subvolumeAttributes returns the attributes shared by subvolumes and subvolume groups
+func subvolumeAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"volume": schema.StringAttribute{
+			MarkdownDescription: "The name of the CephFS volume",
+			Required:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"size": schema.Int64Attribute{
+			MarkdownDescription: "The size quota in bytes. Can be changed in place; unset means no quota.",
+			Optional:            true,
+		},
+		"mode": schema.StringAttribute{
+			MarkdownDescription: "The octal permission bits of the directory (e.g., 755)",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+		"uid": schema.Int64Attribute{
+			MarkdownDescription: "The user ID owning the directory",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"gid": schema.Int64Attribute{
+			MarkdownDescription: "The group ID owning the directory",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+				int64planmodifier.RequiresReplace(),
+			},
+		},
+		"pool_layout": schema.StringAttribute{
+			MarkdownDescription: "The data pool to store file data in. Defaults to the volume's default data pool.",
+			Optional:            true,
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+				stringplanmodifier.RequiresReplace(),
+			},
+		},
+	}
+}
+
+// expandSubvolumeRequest fills the attributes shared by subvolumes and subvolume groups
+func expandSubvolumeRequest(req *client.CephFSSubvolumeRequest, size, uid, gid types.Int64, mode, poolLayout types.String) {
+	req.Size = size.ValueInt64()
+	if !mode.IsUnknown() {
+		req.Mode = mode.ValueString()
+	}
+	if !poolLayout.IsUnknown() {
+		req.PoolLayout = poolLayout.ValueString()
+	}
+	if !uid.IsUnknown() && !uid.IsNull() {
+		v := uid.ValueInt64()
+		req.UID = &v
+	}
+	if !gid.IsUnknown() && !gid.IsNull() {
+		v := gid.ValueInt64()
+		req.GID = &v
+	}
+}
+
+// modeToState converts the mode reported by the API into a state value,
+// keeping the prior value if it denotes the same permissions (e.g. "0755").
+func modeToState(prior types.String, info *client.CephFSSubvolumeInfo) types.String {
+	bits := info.PermissionBits()
+	if !prior.IsNull() && !prior.IsUnknown() {
+		if v, err := strconv.ParseInt(prior.ValueString(), 8, 64); err == nil && strconv.FormatInt(v, 8) == bits {
+			return prior
+		}
+	}
+	return types.StringValue(bits)
+}
+
+// quotaToState converts a quota from the API into a state value, where no quota is null
+func quotaToState(quota client.CephFSQuota) types.Int64 {
+	if quota == 0 {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(quota))
+}
+
+func (r *CephCephFSSubvolumeGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := subvolumeAttributes()
+	attributes["name"] = schema.StringAttribute{
+		MarkdownDescription: "The name of the subvolume group (e.g., csi)",
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a CephFS subvolume group",
+		Attributes:          attributes,
+	}
+}
+
+func (r *CephCephFSSubvolumeGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephCephFSSubvolumeGroupResource) refresh(data *CephCephFSSubvolumeGroupResourceModel) error {
+	info, err := r.client.GetCephFSSubvolumeGroup(data.Volume.ValueString(), data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Size = quotaToState(info.BytesQuota)
+	data.Mode = modeToState(data.Mode, info)
+	data.UID = types.Int64Value(info.UID)
+	data.GID = types.Int64Value(info.GID)
+	data.PoolLayout = types.StringValue(info.DataPool)
+
+	return nil
+}
+
+func (r *CephCephFSSubvolumeGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephCephFSSubvolumeGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	group := client.CephFSSubvolumeRequest{
+		VolName:   data.Volume.ValueString(),
+		GroupName: data.Name.ValueString(),
+	}
+	expandSubvolumeRequest(&group, data.Size, data.UID, data.GID, data.Mode, data.PoolLayout)
+
+	err := r.client.CreateCephFSSubvolumeGroup(group)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create subvolume group: %s", err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created subvolume group: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSSubvolumeGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephCephFSSubvolumeGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(&data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read subvolume group: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSSubvolumeGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephCephFSSubvolumeGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the size can change in place
+	err := r.client.ResizeCephFSSubvolumeGroup(data.Volume.ValueString(), data.Name.ValueString(), data.Size.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resize subvolume group: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSSubvolumeGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephCephFSSubvolumeGroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteCephFSSubvolumeGroup(data.Volume.ValueString(), data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete subvolume group: %s", err))
+		return
+	}
+}
+
+func (r *CephCephFSSubvolumeGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	volume, group, ok := strings.Cut(req.ID, ":")
+	if !ok || volume == "" || group == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <volume>:<group>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("volume"), volume)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), group)...)
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephCephFSVolumeResource{}
+var _ resource.ResourceWithConfigure = &CephCephFSVolumeResource{}
+var _ resource.ResourceWithImportState = &CephCephFSVolumeResource{}
+
+type CephCephFSVolumeResource struct {
+	client *client.Client
+}
+
+type CephCephFSVolumeResourceModel struct {
+	Name               types.String        `tfsdk:"name"`
+	Placement          *CephPlacementModel `tfsdk:"placement"`
+	MaxMDS             types.Int64         `tfsdk:"max_mds"`
+	StandbyCountWanted types.Int64         `tfsdk:"standby_count_wanted"`
+	AllowStandbyReplay types.Bool          `tfsdk:"allow_standby_replay"`
+	DataPool           types.String        `tfsdk:"data_pool"`
+	MetadataPool       types.String        `tfsdk:"metadata_pool"`
+	ConfirmDestroy     types.Bool          `tfsdk:"confirm_destroy"`
+	FsID               types.Int64         `tfsdk:"fs_id"`
+}
+
+func NewCephCephFSVolumeResource() resource.Resource {
+	return &CephCephFSVolumeResource{}
+}
+
+func (r *CephCephFSVolumeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cephfs_volume"
+}
+
+func (r *CephCephFSVolumeResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a CephFS volume (file system) and its MDS service",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the file system",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement": placementAttribute("Placement of the MDS daemons, read from and applied to the `mds.<name>` orchestrator service. Unset leaves the placement to the orchestrator."),
+			"max_mds": schema.Int64Attribute{
+				MarkdownDescription: "The number of active MDS daemons",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"standby_count_wanted": schema.Int64Attribute{
+				MarkdownDescription: "The number of standby MDS daemons wanted before a health warning is raised",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_standby_replay": schema.BoolAttribute{
+				MarkdownDescription: "Whether standby MDS daemons follow the active journal (standby-replay)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_pool": schema.StringAttribute{
+				MarkdownDescription: "The name of the default data pool. Created automatically if not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"metadata_pool": schema.StringAttribute{
+				MarkdownDescription: "The name of the metadata pool. Created automatically if not set.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"confirm_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Must be set to true (and applied) before the file system can be destroyed. " +
+					"Destroying the volume removes all of its data. Default: false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"fs_id": schema.Int64Attribute{
+				MarkdownDescription: "The file system ID (fscid)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephCephFSVolumeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// applySettings sends the file system settings that are set in the plan and
+// differ from the prior state (nil on create).
+func (r *CephCephFSVolumeResource) applySettings(plan *CephCephFSVolumeResourceModel, state *CephCephFSVolumeResourceModel) error {
+	name := plan.Name.ValueString()
+
+	if !plan.MaxMDS.IsUnknown() && !plan.MaxMDS.IsNull() && (state == nil || !plan.MaxMDS.Equal(state.MaxMDS)) {
+		if err := r.client.SetCephFSOption(name, "max_mds", strconv.FormatInt(plan.MaxMDS.ValueInt64(), 10)); err != nil {
+			return err
+		}
+	}
+	if !plan.StandbyCountWanted.IsUnknown() && !plan.StandbyCountWanted.IsNull() && (state == nil || !plan.StandbyCountWanted.Equal(state.StandbyCountWanted)) {
+		if err := r.client.SetCephFSOption(name, "standby_count_wanted", strconv.FormatInt(plan.StandbyCountWanted.ValueInt64(), 10)); err != nil {
+			return err
+		}
+	}
+	if !plan.AllowStandbyReplay.IsUnknown() && !plan.AllowStandbyReplay.IsNull() && (state == nil || !plan.AllowStandbyReplay.Equal(state.AllowStandbyReplay)) {
+		if err := r.client.SetCephFSOption(name, "allow_standby_replay", strconv.FormatBool(plan.AllowStandbyReplay.ValueBool())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// refresh reads the file system back into the model
+func (r *CephCephFSVolumeResource) refresh(ctx context.Context, data *CephCephFSVolumeResourceModel) error {
+	fs, err := r.client.GetCephFS(data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+
+	dataPools, metadataPool, err := r.client.GetCephFSPools(fs.ID)
+	if err != nil {
+		return err
+	}
+
+	data.FsID = types.Int64Value(int64(fs.ID))
+	data.MaxMDS = types.Int64Value(int64(fs.MDSMap.MaxMDS))
+	data.StandbyCountWanted = types.Int64Value(int64(fs.MDSMap.StandbyCountWanted))
+	data.AllowStandbyReplay = types.BoolValue(fs.MDSMap.FlagsState.AllowStandbyReplay)
+	data.MetadataPool = types.StringValue(metadataPool)
+	if len(dataPools) > 0 {
+		data.DataPool = types.StringValue(dataPools[0])
+	}
+	if data.ConfirmDestroy.IsNull() {
+		data.ConfirmDestroy = types.BoolValue(false)
+	}
+
+	// The placement is only tracked when managed, the orchestrator picks a
+	// default one for volumes created without it
+	if data.Placement != nil {
+		service, err := r.client.GetService(client.ServiceName("mds", data.Name.ValueString()))
+		switch {
+		case errors.Is(err, client.ErrNotFound):
+			data.Placement = nil
+		case err != nil:
+			return err
+		default:
+			placement, diags := flattenPlacement(ctx, service.Placement)
+			if diags.HasError() {
+				return fmt.Errorf("unable to set placement: %v", diags)
+			}
+			data.Placement = placement
+		}
+	}
+
+	return nil
+}
+
+func (r *CephCephFSVolumeResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephCephFSVolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	placement, diags := expandPlacement(ctx, data.Placement)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fs := client.CephFSCreate{
+		Name: data.Name.ValueString(),
+		ServiceSpec: client.ServiceSpec{
+			ServiceType: "mds",
+			ServiceID:   data.Name.ValueString(),
+			Placement:   placement,
+		},
+	}
+	if !data.DataPool.IsUnknown() {
+		fs.DataPool = data.DataPool.ValueString()
+	}
+	if !data.MetadataPool.IsUnknown() {
+		fs.MetadataPool = data.MetadataPool.ValueString()
+	}
+
+	err := r.client.CreateCephFS(fs)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create CephFS volume: %s", err))
+		return
+	}
+
+	err = r.applySettings(&data, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure CephFS volume: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created CephFS volume: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSVolumeResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephCephFSVolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CephFS volume: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCephFSVolumeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CephCephFSVolumeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applySettings(&plan, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update CephFS volume: %s", err))
+		return
+	}
+
+	if plan.Placement != nil {
+		placement, diags := expandPlacement(ctx, plan.Placement)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err = r.client.ApplyServiceSpec(client.ServiceSpec{
+			ServiceType: "mds",
+			ServiceID:   plan.Name.ValueString(),
+			Placement:   placement,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MDS placement: %s", err))
+			return
+		}
+	}
+
+	err = r.refresh(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated CephFS volume: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CephCephFSVolumeResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephCephFSVolumeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ConfirmDestroy.ValueBool() {
+		resp.Diagnostics.AddError("Destroy Not Confirmed",
+			fmt.Sprintf("CephFS volume %q was not destroyed because confirm_destroy is false. "+
+				"Set confirm_destroy = true and apply before destroying; all data in the file system will be lost.", data.Name.ValueString()))
+		return
+	}
+
+	err := r.client.DeleteCephFS(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete CephFS volume: %s", err))
+		return
+	}
+}
+
+func (r *CephCephFSVolumeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephConfigResource{}
+var _ resource.ResourceWithConfigure = &CephConfigResource{}
+var _ resource.ResourceWithImportState = &CephConfigResource{}
+var _ resource.ResourceWithModifyPlan = &CephConfigResource{}
+
+type CephConfigResource struct {
+	client *client.Client
+}
+
+type CephConfigResourceModel struct {
+	Section types.String `tfsdk:"section"`
+	Mask    types.String `tfsdk:"mask"`
+	Name    types.String `tfsdk:"name"`
+	Value   types.String `tfsdk:"value"`
+}
+
+func NewCephConfigResource() resource.Resource {
+	return &CephConfigResource{}
+}
+
+func (r *CephConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_config"
+}
+
+func (r *CephConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an option of the centralized configuration database, equivalent to `ceph config set`. " +
+			"Values are validated against the type of the option when planning.",
+		Attributes: map[string]schema.Attribute{
+			"section": schema.StringAttribute{
+				MarkdownDescription: "The daemons the option applies to (e.g., global, mon, osd, osd.3, client.rgw)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mask": schema.StringAttribute{
+				MarkdownDescription: "Restrict the option to the daemons on a host or OSDs of a device class (e.g., `host:ceph-01`, `class:ssd`)",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The option name (e.g., osd_memory_target)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				MarkdownDescription: "The option value (e.g., 4G, true, 600)",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *CephConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan CephConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.Value.IsUnknown() {
+		return
+	}
+
+	option, err := r.client.GetConfigOption(plan.Name.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Unknown Config Option",
+			fmt.Sprintf("%q is not a configuration option of this cluster.", plan.Name.ValueString()))
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddWarning("Unable To Validate Config Value",
+			fmt.Sprintf("The config option %s could not be read, so its value was not validated: %s", plan.Name.ValueString(), err))
+		return
+	}
+
+	err = client.ValidateConfigValue(option, plan.Value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("value"), "Invalid Config Value", err.Error())
+		return
+	}
+
+	if !option.CanUpdateAtRuntime {
+		var state CephConfigResourceModel
+		if !req.State.Raw.IsNull() {
+			resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		}
+		if !plan.Value.Equal(state.Value) {
+			resp.Diagnostics.AddAttributeWarning(path.Root("value"), "Daemon Restart Required",
+				fmt.Sprintf("%s cannot be changed at runtime; the new value only takes effect once the affected daemons are restarted.", option.Name))
+		}
+	}
+}
+
+func (r *CephConfigResource) refresh(data *CephConfigResourceModel) error {
+	name := data.Name.ValueString()
+	option, err := r.client.GetConfigOption(name)
+	if err != nil {
+		return err
+	}
+
+	value, found := client.FindConfigValue(option, data.Section.ValueString(), data.Mask.ValueString(), data.Value.ValueString())
+	if !found {
+		return fmt.Errorf("config option %s for %s %w", name, client.ConfigWho(data.Section.ValueString(), data.Mask.ValueString()), client.ErrNotFound)
+	}
+	// The monitor normalizes the stored value (e.g. 4G becomes 4294967296),
+	// so the configured value is kept as long as both are equivalent
+	if !client.ConfigValuesEqual(option, data.Value.ValueString(), value) {
+		data.Value = types.StringValue(value)
+	}
+
+	return nil
+}
+
+func (r *CephConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	who := client.ConfigWho(data.Section.ValueString(), data.Mask.ValueString())
+	err := r.client.SetConfigValue(data.Name.ValueString(), who, data.Value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set config option: %s", err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config option: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(&data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config option: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephConfigResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	who := client.ConfigWho(data.Section.ValueString(), data.Mask.ValueString())
+	err := r.client.SetConfigValue(data.Name.ValueString(), who, data.Value.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set config option: %s", err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read config option: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephConfigResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	who := client.ConfigWho(data.Section.ValueString(), data.Mask.ValueString())
+	err := r.client.DeleteConfigValue(data.Name.ValueString(), who)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove config option: %s", err))
+		return
+	}
+}
+
+func (r *CephConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Masks contain a colon (e.g. osd/class:ssd:osd_memory_target), option names do not
+	i := strings.LastIndex(req.ID, ":")
+	if i <= 0 || i == len(req.ID)-1 {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <section>:<name> or <section>/<mask>:<name>, got: %s", req.ID))
+		return
+	}
+
+	section, mask, _ := strings.Cut(req.ID[:i], "/")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("section"), section)...)
+	if mask != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("mask"), mask)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), req.ID[i+1:])...)
+}
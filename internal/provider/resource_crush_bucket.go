@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephCrushBucketResource{}
+var _ resource.ResourceWithConfigure = &CephCrushBucketResource{}
+var _ resource.ResourceWithImportState = &CephCrushBucketResource{}
+var _ resource.ResourceWithValidateConfig = &CephCrushBucketResource{}
+var _ resource.ResourceWithModifyPlan = &CephCrushBucketResource{}
+
+// crushLocationOption is the config option OSDs use to place themselves in
+// the CRUSH hierarchy when they start
+const crushLocationOption = "crush_location"
+
+type CephCrushBucketResource struct {
+	client *client.Client
+}
+
+type CephCrushBucketResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Location types.Map    `tfsdk:"location"`
+	Parent   types.String `tfsdk:"parent"`
+	ID       types.Int64  `tfsdk:"id"`
+}
+
+func NewCephCrushBucketResource() resource.Resource {
+	return &CephCrushBucketResource{}
+}
+
+func (r *CephCrushBucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_crush_bucket"
+}
+
+func (r *CephCrushBucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the position of a host bucket in the CRUSH hierarchy, e.g. a host under a rack. " +
+			"The Ceph Dashboard API has no endpoint to create or move CRUSH buckets, so the location is set as the `crush_location` " +
+			"of the OSDs on the host (`ceph config set osd/host:<name> crush_location ...`): OSDs created on the host afterwards " +
+			"place it there and Ceph creates missing buckets such as racks and datacenters. " +
+			"Hosts that already hold OSDs are adopted when they are at the configured location; they cannot be moved by Terraform.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the host bucket, i.e. the host name of its OSDs",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"location": schema.MapAttribute{
+				MarkdownDescription: "The buckets above the host keyed by bucket type (e.g., `{ rack = \"rack1\", root = \"default\" }`). " +
+					"Buckets that do not exist yet are created by Ceph.",
+				Required:    true,
+				ElementType: types.StringType,
+			},
+			"parent": schema.StringAttribute{
+				MarkdownDescription: "The name of the bucket the host is currently placed under. Empty until the first OSD of the host is created.",
+				Computed:            true,
+			},
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "The bucket ID assigned by Ceph. Empty until the first OSD of the host is created.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephCrushBucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephCrushBucketResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephCrushBucketResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Location.IsNull() || data.Location.IsUnknown() {
+		return
+	}
+
+	location := map[string]types.String{}
+	resp.Diagnostics.Append(data.Location.ElementsAs(ctx, &location, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(location) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("location"), "Invalid CRUSH Location",
+			"location must contain at least the root of the host, e.g. { root = \"default\" }.")
+		return
+	}
+	for bucketType, name := range location {
+		if name.IsUnknown() {
+			continue
+		}
+		if bucketType == "host" || bucketType == "osd" {
+			resp.Diagnostics.AddAttributeError(path.Root("location").AtMapKey(bucketType), "Invalid CRUSH Location",
+				fmt.Sprintf("location holds the buckets above the host and cannot contain %q.", bucketType))
+			continue
+		}
+		if strings.ContainsAny(bucketType, "= ") || name.ValueString() == "" || strings.ContainsAny(name.ValueString(), "= ") {
+			resp.Diagnostics.AddAttributeError(path.Root("location").AtMapKey(bucketType), "Invalid CRUSH Location",
+				fmt.Sprintf("Bucket types and names cannot be empty or contain spaces or '=', got: %s=%s", bucketType, name.ValueString()))
+		}
+	}
+}
+
+func (r *CephCrushBucketResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan CephCrushBucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() || plan.Location.IsUnknown() {
+		return
+	}
+
+	elements := map[string]types.String{}
+	resp.Diagnostics.Append(plan.Location.ElementsAs(ctx, &elements, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	location := map[string]string{}
+	for bucketType, name := range elements {
+		if name.IsUnknown() {
+			return
+		}
+		location[bucketType] = name.ValueString()
+	}
+
+	name := plan.Name.ValueString()
+	_, ancestors, err := r.findHost(name)
+	if errors.Is(err, client.ErrNotFound) {
+		if req.State.Raw.IsNull() {
+			_, err = r.client.GetHost(name)
+			if errors.Is(err, client.ErrNotFound) {
+				resp.Diagnostics.AddAttributeWarning(path.Root("name"), "Unknown Host",
+					fmt.Sprintf("%s is not a host of the orchestrator. Its OSDs are only placed at the location once they are created on a host of that name.", name))
+			}
+		}
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CRUSH bucket: %s", err))
+		return
+	}
+
+	if current := crushLocation(ancestors); !maps.Equal(current, location) {
+		resp.Diagnostics.AddAttributeError(path.Root("location"), "CRUSH Bucket Move Not Supported",
+			fmt.Sprintf("Host %s is placed at %q. The Ceph Dashboard API cannot move CRUSH buckets, and the crush_location "+
+				"only places hosts whose OSDs do not exist yet. Move the host with `ceph osd crush move %s %s`, "+
+				"which rebalances all data stored on its OSDs, then apply again.",
+				name, client.FormatCrushLocation(current), name, client.FormatCrushLocation(location)))
+	}
+}
+
+// findHost returns an existing host bucket together with the buckets above it
+func (r *CephCrushBucketResource) findHost(name string) (*client.CrushNode, []client.CrushNode, error) {
+	nodes, err := r.client.GetCrushTree()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for i, n := range nodes {
+		if n.Name != name {
+			continue
+		}
+		if n.Type != "host" {
+			return nil, nil, fmt.Errorf("crush node %s is a %s, only host buckets are supported", name, n.Type)
+		}
+		return &nodes[i], client.CrushAncestors(nodes, n.ID), nil
+	}
+
+	return nil, nil, fmt.Errorf("crush node %s %w", name, client.ErrNotFound)
+}
+
+// crushLocation keys the names of buckets by their type
+func crushLocation(buckets []client.CrushNode) map[string]string {
+	location := map[string]string{}
+	for _, b := range buckets {
+		location[b.Type] = b.Name
+	}
+	return location
+}
+
+// setLocation stores the planned location as the crush_location of the OSDs on the host
+func (r *CephCrushBucketResource) setLocation(ctx context.Context, data *CephCrushBucketResourceModel) error {
+	location := map[string]string{}
+	diags := data.Location.ElementsAs(ctx, &location, false)
+	if diags.HasError() {
+		return fmt.Errorf("unable to convert location")
+	}
+	location["host"] = data.Name.ValueString()
+
+	who := client.ConfigWho("osd", "host:"+data.Name.ValueString())
+	return r.client.SetConfigValue(crushLocationOption, who, client.FormatCrushLocation(location))
+}
+
+// refresh reads the configured location and the current position of the host back into the model
+func (r *CephCrushBucketResource) refresh(ctx context.Context, data *CephCrushBucketResourceModel) error {
+	name := data.Name.ValueString()
+	option, err := r.client.GetConfigOption(crushLocationOption)
+	if err != nil {
+		return err
+	}
+
+	expected := map[string]string{}
+	data.Location.ElementsAs(ctx, &expected, false)
+	expected["host"] = name
+	value, found := client.FindConfigValue(option, "osd", "host:"+name, client.FormatCrushLocation(expected))
+	if !found {
+		return fmt.Errorf("crush location of host %s %w", name, client.ErrNotFound)
+	}
+
+	location, err := client.ParseCrushLocation(value)
+	if err != nil {
+		return err
+	}
+	delete(location, "host")
+
+	data.Parent = types.StringNull()
+	data.ID = types.Int64Null()
+	host, ancestors, err := r.findHost(name)
+	switch {
+	case errors.Is(err, client.ErrNotFound):
+		// No OSD has been created on the host yet
+	case err != nil:
+		return err
+	default:
+		data.ID = types.Int64Value(int64(host.ID))
+		if len(ancestors) > 0 {
+			data.Parent = types.StringValue(ancestors[0].Name)
+		}
+		// A host moved outside of Terraform shows up as a change of location
+		location = crushLocation(ancestors)
+	}
+
+	locationValue, diags := types.MapValueFrom(ctx, types.StringType, location)
+	if diags.HasError() {
+		return fmt.Errorf("unable to convert location")
+	}
+	data.Location = locationValue
+
+	return nil
+}
+
+func (r *CephCrushBucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephCrushBucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setLocation(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set CRUSH location: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CRUSH bucket: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCrushBucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephCrushBucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CRUSH bucket: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCrushBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephCrushBucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.setLocation(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set CRUSH location: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CRUSH bucket: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephCrushBucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephCrushBucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The bucket itself stays in the CRUSH map, only new OSDs stop being placed
+	who := client.ConfigWho("osd", "host:"+data.Name.ValueString())
+	err := r.client.DeleteConfigValue(crushLocationOption, who)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove CRUSH location: %s", err))
+		return
+	}
+}
+
+func (r *CephCrushBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
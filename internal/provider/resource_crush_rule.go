@@ -2,13 +2,18 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
@@ -16,6 +21,8 @@ import (
 var _ resource.Resource = &CephCrushRuleResource{}
 var _ resource.ResourceWithConfigure = &CephCrushRuleResource{}
 var _ resource.ResourceWithImportState = &CephCrushRuleResource{}
+var _ resource.ResourceWithValidateConfig = &CephCrushRuleResource{}
+var _ resource.ResourceWithModifyPlan = &CephCrushRuleResource{}
 
 type CephCrushRuleResource struct {
 	client *client.Client
@@ -23,12 +30,31 @@ type CephCrushRuleResource struct {
 
 type CephCrushRuleResourceModel struct {
 	Name          types.String `tfsdk:"name"`
+	Type          types.String `tfsdk:"type"`
 	Root          types.String `tfsdk:"root"`
 	FailureDomain types.String `tfsdk:"failure_domain"`
 	DeviceClass   types.String `tfsdk:"device_class"`
+	Steps         types.List   `tfsdk:"steps"`
 	RuleID        types.Int64  `tfsdk:"rule_id"`
 }
 
+type CephCrushRuleResourceStepModel struct {
+	Op       types.String `tfsdk:"op"`
+	ItemName types.String `tfsdk:"item_name"`
+	Num      types.Int64  `tfsdk:"num"`
+	Type     types.String `tfsdk:"type"`
+}
+
+// crushRuleStepType is the object type of the steps of the ceph_crush_rule resource
+var crushRuleStepType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"op":        types.StringType,
+		"item_name": types.StringType,
+		"num":       types.Int64Type,
+		"type":      types.StringType,
+	},
+}
+
 func NewCephCrushRuleResource() resource.Resource {
 	return &CephCrushRuleResource{}
 }
@@ -39,6 +65,7 @@ func (r *CephCrushRuleResource) Metadata(ctx context.Context, req resource.Metad
 
 func (r *CephCrushRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "Manages a Ceph CRUSH rule for data placement",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
@@ -48,27 +75,72 @@ func (r *CephCrushRuleResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "The rule type, `replicated` or `erasure`. Default: replicated. " +
+					"Only replicated rules can be created: Ceph creates erasure rules from the erasure code profile of an erasure pool " +
+					"(see `erasure_code_profile` and `rule_name` of `ceph_pool`). Such rules can be imported.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("replicated"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"root": schema.StringAttribute{
 				MarkdownDescription: "The root bucket (e.g., default, host name)",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"failure_domain": schema.StringAttribute{
 				MarkdownDescription: "The failure domain type (e.g., host, osd)",
-				Required:            true,
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
 			"device_class": schema.StringAttribute{
 				MarkdownDescription: "The device class (hdd, ssd, or empty for all)",
 				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"steps": schema.ListNestedAttribute{
+				MarkdownDescription: "The ordered list of steps of the rule, as read from the cluster. " +
+					"Rules cannot be created from steps, as the Ceph Dashboard API only creates rules from `root`/`failure_domain`/`device_class`.",
+				Computed: true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"op": schema.StringAttribute{
+							MarkdownDescription: "The step operation: take, choose_firstn, chooseleaf_firstn, choose_indep, chooseleaf_indep, emit, set_choose_tries or set_chooseleaf_tries",
+							Computed:            true,
+						},
+						"item_name": schema.StringAttribute{
+							MarkdownDescription: "The bucket to start from, for `take` steps (e.g., `default` or `default~ssd`)",
+							Computed:            true,
+						},
+						"num": schema.Int64Attribute{
+							MarkdownDescription: "The number of buckets to choose (0 means pool size, negative means pool size minus num), or the number of tries for `set_*` steps",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The bucket type to choose, for choose steps (e.g., host, rack)",
+							Computed:            true,
+						},
+					},
+				},
+			},
 			"rule_id": schema.Int64Attribute{
 				MarkdownDescription: "The CRUSH rule ID assigned by Ceph",
 				Computed:            true,
@@ -77,6 +149,93 @@ func (r *CephCrushRuleResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+func (r *CephCrushRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephCrushRuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ruleType := data.Type.ValueString()
+	if !data.Type.IsNull() && !data.Type.IsUnknown() && ruleType != "replicated" && ruleType != "erasure" {
+		resp.Diagnostics.AddAttributeError(path.Root("type"), "Invalid CRUSH Rule Type",
+			fmt.Sprintf("type must be replicated or erasure, got: %s", ruleType))
+		return
+	}
+
+	if data.Root.IsNull() || data.FailureDomain.IsNull() {
+		resp.Diagnostics.AddError("Missing CRUSH Rule Placement",
+			"root and failure_domain must be set.")
+	}
+}
+
+func (r *CephCrushRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var ruleType types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &ruleType)...)
+	if resp.Diagnostics.HasError() || ruleType.ValueString() != "erasure" {
+		return
+	}
+
+	// Replacing an imported erasure rule would delete it before failing to
+	// create it again, so the plan is refused instead.
+	if !req.State.Raw.IsNull() && len(resp.RequiresReplace) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(path.Root("type"), "Unsupported CRUSH Rule Type",
+		"The Ceph Dashboard API only creates replicated CRUSH rules, so erasure rules can neither be created nor replaced. "+
+			"Ceph creates an erasure rule from the erasure code profile when an erasure pool is created: set type = \"erasure\", "+
+			"erasure_code_profile and rule_name on ceph_pool, then import the rule to manage it with ceph_crush_rule.")
+}
+
+// flattenCrushRuleSteps converts client steps into the steps of the resource.
+// Bucket counts and tries only apply to choose and set_* steps.
+func flattenCrushRuleSteps(ctx context.Context, steps []client.CrushRuleStep) (types.List, diag.Diagnostics) {
+	result := []CephCrushRuleResourceStepModel{}
+	for _, step := range steps {
+		s := CephCrushRuleResourceStepModel{
+			Op:       types.StringValue(step.Op),
+			ItemName: types.StringNull(),
+			Num:      types.Int64Null(),
+			Type:     types.StringNull(),
+		}
+		if step.ItemName != "" {
+			s.ItemName = types.StringValue(step.ItemName)
+		}
+		if step.Op != "take" && step.Op != "emit" {
+			s.Num = types.Int64Value(int64(step.Num))
+		}
+		if step.Type != "" {
+			s.Type = types.StringValue(step.Type)
+		}
+		result = append(result, s)
+	}
+	return types.ListValueFrom(ctx, crushRuleStepType, result)
+}
+
+// setCrushRulePlacement refreshes the computed attributes of the model from
+// the CRUSH rule returned by the API.
+func setCrushRulePlacement(ctx context.Context, data *CephCrushRuleResourceModel, rule *client.CrushRuleResponse) diag.Diagnostics {
+	root, failureDomain, deviceClass := rule.Placement()
+	data.RuleID = types.Int64Value(int64(rule.RuleID))
+	data.Type = types.StringValue(rule.TypeName())
+	data.Root = types.StringValue(root)
+	data.FailureDomain = types.StringValue(failureDomain)
+	if deviceClass != "" {
+		data.DeviceClass = types.StringValue(deviceClass)
+	} else {
+		data.DeviceClass = types.StringNull()
+	}
+
+	steps, diags := flattenCrushRuleSteps(ctx, rule.Steps)
+	data.Steps = steps
+	return diags
+}
+
 func (r *CephCrushRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -114,13 +273,13 @@ func (r *CephCrushRuleResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	// Read back to get rule_id
+	// Read back to get rule_id and the derived placement
 	created, err := r.client.GetCrushRule(data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created CRUSH rule: %s", err))
 		return
 	}
-	data.RuleID = types.Int64Value(int64(created.RuleID))
+	resp.Diagnostics.Append(setCrushRulePlacement(ctx, &data, created)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -133,12 +292,18 @@ func (r *CephCrushRuleResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	rule, err := r.client.GetCrushRule(data.Name.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CRUSH rule: %s", err))
 		return
 	}
 
-	data.RuleID = types.Int64Value(int64(rule.RuleID))
+	// Reconstruct the placement from the rule steps so that imported rules
+	// and rules changed outside of Terraform produce accurate plans.
+	resp.Diagnostics.Append(setCrushRulePlacement(ctx, &data, rule)...)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
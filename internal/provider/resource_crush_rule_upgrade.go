@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithUpgradeState = &CephCrushRuleResource{}
+
+// CephCrushRuleResourceModelV0 describes the ceph_crush_rule state written
+// before schema versioning was introduced.
+type CephCrushRuleResourceModelV0 struct {
+	Name          types.String `tfsdk:"name"`
+	Root          types.String `tfsdk:"root"`
+	FailureDomain types.String `tfsdk:"failure_domain"`
+	DeviceClass   types.String `tfsdk:"device_class"`
+	RuleID        types.Int64  `tfsdk:"rule_id"`
+}
+
+// crushRuleSchemaV0 is a frozen copy of the version 0 ceph_crush_rule
+// schema. It must not be changed, as it is used to decode existing state
+// files.
+func crushRuleSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":           schema.StringAttribute{Required: true},
+			"root":           schema.StringAttribute{Required: true},
+			"failure_domain": schema.StringAttribute{Required: true},
+			"device_class":   schema.StringAttribute{Optional: true},
+			"rule_id":        schema.Int64Attribute{Computed: true},
+		},
+	}
+}
+
+func (r *CephCrushRuleResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: crushRuleSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior CephCrushRuleResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := CephCrushRuleResourceModel{
+					Name:          prior.Name,
+					Root:          prior.Root,
+					FailureDomain: prior.FailureDomain,
+					DeviceClass:   prior.DeviceClass,
+					Steps:         types.ListNull(crushRuleStepType),
+					RuleID:        prior.RuleID,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCephCrushRuleResourceUpgradeStateV0(t *testing.T) {
+	state := testUpgradeState(t, &CephCrushRuleResource{}, 0, "crush_rule_v0.json")
+
+	var data CephCrushRuleResourceModel
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("unable to read upgraded state: %v", diags)
+	}
+
+	if got := data.Name.ValueString(); got != "ssd_hosts_rule" {
+		t.Errorf("name = %q, want %q", got, "ssd_hosts_rule")
+	}
+	if got := data.Root.ValueString(); got != "default" {
+		t.Errorf("root = %q, want %q", got, "default")
+	}
+	if got := data.FailureDomain.ValueString(); got != "host" {
+		t.Errorf("failure_domain = %q, want %q", got, "host")
+	}
+	if got := data.DeviceClass.ValueString(); got != "ssd" {
+		t.Errorf("device_class = %q, want %q", got, "ssd")
+	}
+	if got := data.RuleID.ValueInt64(); got != 2 {
+		t.Errorf("rule_id = %d, want 2", got)
+	}
+}
@@ -0,0 +1,253 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephDashboardRoleResource{}
+var _ resource.ResourceWithConfigure = &CephDashboardRoleResource{}
+var _ resource.ResourceWithImportState = &CephDashboardRoleResource{}
+var _ resource.ResourceWithValidateConfig = &CephDashboardRoleResource{}
+
+type CephDashboardRoleResource struct {
+	client *client.Client
+}
+
+type CephDashboardRoleResourceModel struct {
+	Name              types.String `tfsdk:"name"`
+	Description       types.String `tfsdk:"description"`
+	ScopesPermissions types.Map    `tfsdk:"scopes_permissions"`
+}
+
+func NewCephDashboardRoleResource() resource.Resource {
+	return &CephDashboardRoleResource{}
+}
+
+func (r *CephDashboardRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_role"
+}
+
+func (r *CephDashboardRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a custom Ceph Dashboard role. System roles (e.g., administrator, read-only) cannot be managed.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The role name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The role description",
+				Optional:            true,
+			},
+			"scopes_permissions": schema.MapAttribute{
+				MarkdownDescription: "The permissions (read, create, update, delete) granted per scope " +
+					"(e.g., pool, rbd-image, cephfs, rgw, hosts, osd, monitor, config-opt, grafana, prometheus, log, user)",
+				ElementType: types.ListType{ElemType: types.StringType},
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *CephDashboardRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephDashboardRoleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephDashboardRoleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.ScopesPermissions.IsUnknown() {
+		return
+	}
+
+	for scope, value := range data.ScopesPermissions.Elements() {
+		permissions, ok := value.(types.List)
+		if !ok || permissions.IsUnknown() {
+			continue
+		}
+		for _, element := range permissions.Elements() {
+			permission, ok := element.(types.String)
+			if !ok || permission.IsUnknown() {
+				continue
+			}
+			if !slices.Contains(client.DashboardPermissions, permission.ValueString()) {
+				resp.Diagnostics.AddAttributeError(path.Root("scopes_permissions").AtMapKey(scope), "Invalid Permission",
+					fmt.Sprintf("%q is not a dashboard permission. Expected read, create, update or delete.", permission.ValueString()))
+			}
+		}
+	}
+}
+
+// flattenScopesPermissions converts role permissions into a map, keeping the
+// order of the prior permission lists when they hold the same permissions
+func flattenScopesPermissions(ctx context.Context, prior types.Map, scopesPermissions map[string][]string) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	priorElements := map[string]types.List{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorElements, false)...)
+	}
+
+	result := map[string]types.List{}
+	for scope, permissions := range scopesPermissions {
+		priorPermissions, ok := priorElements[scope]
+		if !ok {
+			priorPermissions = types.ListNull(types.StringType)
+		}
+		list, d := flattenUnorderedList(ctx, priorPermissions, permissions)
+		diags.Append(d...)
+		result[scope] = list
+	}
+
+	m, d := types.MapValueFrom(ctx, types.ListType{ElemType: types.StringType}, result)
+	diags.Append(d...)
+	return m, diags
+}
+
+func (r *CephDashboardRoleResource) expand(ctx context.Context, data *CephDashboardRoleResourceModel) (client.DashboardRole, diag.Diagnostics) {
+	role := client.DashboardRole{
+		Name:              data.Name.ValueString(),
+		Description:       data.Description.ValueString(),
+		ScopesPermissions: map[string][]string{},
+	}
+	diags := data.ScopesPermissions.ElementsAs(ctx, &role.ScopesPermissions, false)
+	return role, diags
+}
+
+func (r *CephDashboardRoleResource) refresh(ctx context.Context, data *CephDashboardRoleResourceModel) error {
+	role, err := r.client.GetDashboardRole(data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Description = optionalString(role.Description)
+
+	var diags diag.Diagnostics
+	data.ScopesPermissions, diags = flattenScopesPermissions(ctx, data.ScopesPermissions, role.ScopesPermissions)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set scopes permissions: %v", diags)
+	}
+
+	return nil
+}
+
+func (r *CephDashboardRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephDashboardRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDashboardRole(role)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard role: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created dashboard role: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephDashboardRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephDashboardRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dashboard role: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephDashboardRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephDashboardRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateDashboardRole(role)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dashboard role: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated dashboard role: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephDashboardRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephDashboardRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDashboardRole(data.Name.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dashboard role: %s", err))
+		return
+	}
+}
+
+func (r *CephDashboardRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
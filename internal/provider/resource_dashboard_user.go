@@ -0,0 +1,343 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephDashboardUserResource{}
+var _ resource.ResourceWithConfigure = &CephDashboardUserResource{}
+var _ resource.ResourceWithImportState = &CephDashboardUserResource{}
+var _ resource.ResourceWithValidateConfig = &CephDashboardUserResource{}
+var _ resource.ResourceWithModifyPlan = &CephDashboardUserResource{}
+
+type CephDashboardUserResource struct {
+	client *client.Client
+}
+
+type CephDashboardUserResourceModel struct {
+	Username          types.String `tfsdk:"username"`
+	Password          types.String `tfsdk:"password"`
+	Name              types.String `tfsdk:"name"`
+	Email             types.String `tfsdk:"email"`
+	Roles             types.List   `tfsdk:"roles"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	PwdExpirationDate types.String `tfsdk:"pwd_expiration_date"`
+	PwdUpdateRequired types.Bool   `tfsdk:"pwd_update_required"`
+}
+
+func NewCephDashboardUserResource() resource.Resource {
+	return &CephDashboardUserResource{}
+}
+
+func (r *CephDashboardUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dashboard_user"
+}
+
+func (r *CephDashboardUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Ceph Dashboard user account. Passwords are checked against the dashboard password policy when planning.",
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The login name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password. It cannot be read back, so changes made outside of Terraform are not detected.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The full name of the user",
+				Optional:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user",
+				Optional:            true,
+			},
+			"roles": schema.ListAttribute{
+				MarkdownDescription: "The roles of the user (e.g., administrator, read-only, block-manager, or a `ceph_dashboard_role`). Default: [].",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user can log in. Default: true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"pwd_expiration_date": schema.StringAttribute{
+				MarkdownDescription: "When the password expires (RFC 3339, e.g., 2026-12-31T00:00:00Z). " +
+					"Defaults to the expiration span configured in the dashboard, if any.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"pwd_update_required": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user must change the password at the next login. " +
+					"Only applied when the password is set or changed, since the dashboard clears it once the user has changed the password. Default: false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *CephDashboardUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephDashboardUserResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephDashboardUserResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.PwdExpirationDate.IsNull() && !data.PwdExpirationDate.IsUnknown() {
+		if _, err := time.Parse(time.RFC3339, data.PwdExpirationDate.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("pwd_expiration_date"), "Invalid Expiration Date",
+				fmt.Sprintf("Expected an RFC 3339 date (e.g., 2026-12-31T00:00:00Z), got: %s", data.PwdExpirationDate.ValueString()))
+		}
+	}
+}
+
+func (r *CephDashboardUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan CephDashboardUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Password.IsUnknown() || plan.Username.IsUnknown() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state CephDashboardUserResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || plan.Password.Equal(state.Password) {
+			return
+		}
+	}
+
+	check, err := r.client.ValidateDashboardPassword(plan.Username.ValueString(), plan.Password.ValueString())
+	if err != nil || check.Valid {
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(path.Root("password"), "Password Policy Not Met",
+		fmt.Sprintf("The password of dashboard user %q does not meet the dashboard password policy (%s); "+
+			"the dashboard will refuse it when applying.", plan.Username.ValueString(), check.Valuation))
+}
+
+// expandExpirationDate converts an RFC 3339 date into a Unix timestamp
+func expandExpirationDate(value types.String) (*int64, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		return nil, err
+	}
+	ts := t.Unix()
+	return &ts, nil
+}
+
+func (r *CephDashboardUserResource) expand(ctx context.Context, data *CephDashboardUserResourceModel) (client.DashboardUserRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	user := client.DashboardUserRequest{
+		DashboardUser: client.DashboardUser{
+			Username:          data.Username.ValueString(),
+			Name:              data.Name.ValueString(),
+			Email:             data.Email.ValueString(),
+			Roles:             []string{},
+			Enabled:           data.Enabled.ValueBool(),
+			PwdUpdateRequired: data.PwdUpdateRequired.ValueBool(),
+		},
+		Password: data.Password.ValueString(),
+	}
+	diags.Append(data.Roles.ElementsAs(ctx, &user.Roles, false)...)
+
+	expiration, err := expandExpirationDate(data.PwdExpirationDate)
+	if err != nil {
+		diags.AddAttributeError(path.Root("pwd_expiration_date"), "Invalid Expiration Date", err.Error())
+	}
+	user.PwdExpirationDate = expiration
+
+	return user, diags
+}
+
+func (r *CephDashboardUserResource) refresh(ctx context.Context, data *CephDashboardUserResourceModel) error {
+	user, err := r.client.GetDashboardUser(data.Username.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Name = optionalString(user.Name)
+	data.Email = optionalString(user.Email)
+	data.Enabled = types.BoolValue(user.Enabled)
+
+	var diags diag.Diagnostics
+	data.Roles, diags = flattenUnorderedList(ctx, data.Roles, user.Roles)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set roles: %v", diags)
+	}
+
+	if user.PwdExpirationDate == nil {
+		data.PwdExpirationDate = types.StringNull()
+	} else {
+		expiration := time.Unix(*user.PwdExpirationDate, 0).UTC()
+		prior, err := time.Parse(time.RFC3339, data.PwdExpirationDate.ValueString())
+		if err != nil || !prior.Equal(expiration) {
+			data.PwdExpirationDate = types.StringValue(expiration.Format(time.RFC3339))
+		}
+	}
+
+	// The dashboard clears the flag once the password was changed, so the configured value is kept
+	if data.PwdUpdateRequired.IsNull() {
+		data.PwdUpdateRequired = types.BoolValue(user.PwdUpdateRequired)
+	}
+
+	return nil
+}
+
+func (r *CephDashboardUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephDashboardUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateDashboardUser(user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create dashboard user: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created dashboard user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephDashboardUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephDashboardUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dashboard user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephDashboardUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CephDashboardUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Password.Equal(state.Password) {
+		// Keep the password and the current password change requirement
+		current, err := r.client.GetDashboardUser(user.Username)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read dashboard user: %s", err))
+			return
+		}
+		user.Password = ""
+		user.PwdUpdateRequired = current.PwdUpdateRequired
+	}
+
+	err := r.client.UpdateDashboardUser(user)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update dashboard user: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated dashboard user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephDashboardUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephDashboardUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDashboardUser(data.Username.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete dashboard user: %s", err))
+		return
+	}
+}
+
+func (r *CephDashboardUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("username"), req, resp)
+}
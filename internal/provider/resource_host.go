@@ -0,0 +1,295 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephHostResource{}
+var _ resource.ResourceWithConfigure = &CephHostResource{}
+var _ resource.ResourceWithImportState = &CephHostResource{}
+
+// hostDrainTimeout bounds the wait for the daemons of a drained host to be removed
+const hostDrainTimeout = 30 * time.Minute
+
+type CephHostResource struct {
+	client *client.Client
+}
+
+type CephHostResourceModel struct {
+	Hostname    types.String `tfsdk:"hostname"`
+	Addr        types.String `tfsdk:"addr"`
+	Labels      types.List   `tfsdk:"labels"`
+	Maintenance types.Bool   `tfsdk:"maintenance"`
+	Services    types.List   `tfsdk:"services"`
+}
+
+func NewCephHostResource() resource.Resource {
+	return &CephHostResource{}
+}
+
+func (r *CephHostResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_host"
+}
+
+func (r *CephHostResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a host of the orchestrator (cephadm), equivalent to `ceph orch host add`. " +
+			"On destroy, the host is drained and removed once all its daemons are gone.",
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname, as reported by `hostname` on the host",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"addr": schema.StringAttribute{
+				MarkdownDescription: "The address the orchestrator connects to. Defaults to the resolved hostname.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"labels": schema.ListAttribute{
+				MarkdownDescription: "The labels of the host, used by placement specifications (e.g., `_admin`, `mon`, `rgw`). Default: [].",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{})),
+			},
+			"maintenance": schema.BoolAttribute{
+				MarkdownDescription: "Whether the host is in maintenance mode, i.e. its daemons are stopped. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"services": schema.ListAttribute{
+				MarkdownDescription: "The services with daemons on the host (e.g., mon.a, rgw.default)",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CephHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// flattenUnorderedList converts values whose order is irrelevant (e.g. host
+// labels) into a list, keeping the order of the prior list when it holds the
+// same values
+func flattenUnorderedList(ctx context.Context, prior types.List, values []string) (types.List, diag.Diagnostics) {
+	if values == nil {
+		values = []string{}
+	}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		var priorValues []string
+		diags := prior.ElementsAs(ctx, &priorValues, false)
+		if diags.HasError() {
+			return prior, diags
+		}
+		a, b := slices.Clone(priorValues), slices.Clone(values)
+		slices.Sort(a)
+		slices.Sort(b)
+		if slices.Equal(a, b) {
+			return prior, nil
+		}
+	}
+	return types.ListValueFrom(ctx, types.StringType, values)
+}
+
+func (r *CephHostResource) refresh(ctx context.Context, data *CephHostResourceModel) error {
+	host, err := r.client.GetHost(data.Hostname.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.Addr = types.StringValue(host.Addr)
+	data.Maintenance = types.BoolValue(host.Status == client.HostStatusMaintenance)
+
+	var diags diag.Diagnostics
+	data.Labels, diags = flattenUnorderedList(ctx, data.Labels, host.Labels)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set labels: %v", diags)
+	}
+
+	services := []string{}
+	for _, service := range host.Services {
+		services = append(services, client.ServiceName(service.Type, service.ID))
+	}
+	data.Services, diags = types.ListValueFrom(ctx, types.StringType, services)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set services: %v", diags)
+	}
+
+	return nil
+}
+
+func (r *CephHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephHostResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var labels []string
+	resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateHost(data.Hostname.ValueString(), data.Addr.ValueString(), labels, data.Maintenance.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add host: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read added host: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephHostResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephHostResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read host: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CephHostResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostname := data.Hostname.ValueString()
+
+	if !data.Labels.Equal(state.Labels) {
+		var labels []string
+		resp.Diagnostics.Append(data.Labels.ElementsAs(ctx, &labels, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.client.SetHostLabels(hostname, labels)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update host labels: %s", err))
+			return
+		}
+	}
+
+	if !data.Maintenance.Equal(state.Maintenance) {
+		err := r.client.SetHostMaintenance(hostname, data.Maintenance.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to change host maintenance mode: %s", err))
+			return
+		}
+	}
+
+	err := r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated host: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephHostResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephHostResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hostname := data.Hostname.ValueString()
+
+	// The daemons of a host in maintenance mode are stopped and cannot be removed
+	err := r.client.SetHostMaintenance(hostname, false)
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to exit host maintenance mode: %s", err))
+		return
+	}
+
+	err = r.client.DrainHost(hostname)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to drain host: %s", err))
+		return
+	}
+
+	err = waitFor(ctx, hostDrainTimeout, func() (bool, error) {
+		daemons, err := r.client.ListHostDaemons(hostname)
+		if err != nil {
+			return false, err
+		}
+		return len(daemons) == 0, nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for the daemons of host %s to be removed: %s", hostname, err))
+		return
+	}
+
+	err = r.client.DeleteHost(hostname)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove host: %s", err))
+		return
+	}
+}
+
+func (r *CephHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("hostname"), req, resp)
+}
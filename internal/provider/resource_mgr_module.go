@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephMgrModuleResource{}
+var _ resource.ResourceWithConfigure = &CephMgrModuleResource{}
+var _ resource.ResourceWithImportState = &CephMgrModuleResource{}
+var _ resource.ResourceWithValidateConfig = &CephMgrModuleResource{}
+var _ resource.ResourceWithModifyPlan = &CephMgrModuleResource{}
+
+// mgrModuleTimeout bounds the wait for a manager module to be enabled or disabled
+const mgrModuleTimeout = 2 * time.Minute
+
+type CephMgrModuleResource struct {
+	client *client.Client
+}
+
+type CephMgrModuleResourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Enabled  types.Bool   `tfsdk:"enabled"`
+	Options  types.Map    `tfsdk:"options"`
+	AlwaysOn types.Bool   `tfsdk:"always_on"`
+}
+
+func NewCephMgrModuleResource() resource.Resource {
+	return &CephMgrModuleResource{}
+}
+
+func (r *CephMgrModuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mgr_module"
+}
+
+func (r *CephMgrModuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a manager module and its options. Destroying this resource leaves the module and its options as they are.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The module name (e.g., prometheus, balancer, telemetry)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the module is enabled. Always-on modules cannot be disabled. Default: true.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"options": schema.MapAttribute{
+				MarkdownDescription: "The module options to set (e.g., `{ server_port = \"9283\" }`), with values as reported by Ceph (e.g., `true` for booleans). " +
+					"Only the configured options are compared with the cluster; removed options are reset to their default.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"always_on": schema.BoolAttribute{
+				MarkdownDescription: "Whether the module is always on",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephMgrModuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephMgrModuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephMgrModuleResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Name.ValueString() == "dashboard" && !data.Enabled.IsNull() && !data.Enabled.IsUnknown() && !data.Enabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("enabled"), "Cannot Disable Dashboard",
+			"The provider manages the cluster through the dashboard module, which therefore cannot be disabled.")
+	}
+}
+
+func (r *CephMgrModuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan CephMgrModuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.Name.IsUnknown() {
+		return
+	}
+
+	module, err := r.client.GetMgrModule(plan.Name.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Unknown Manager Module",
+			fmt.Sprintf("%q is not a manager module of this cluster.", plan.Name.ValueString()))
+		return
+	}
+	if err != nil {
+		return
+	}
+
+	if module.AlwaysOn && !plan.Enabled.IsUnknown() && !plan.Enabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("enabled"), "Always-On Manager Module",
+			fmt.Sprintf("The %s module is always on and cannot be disabled.", module.Name))
+	}
+
+	if plan.Options.IsNull() || plan.Options.IsUnknown() {
+		return
+	}
+	for key := range plan.Options.Elements() {
+		if _, ok := module.Options[key]; !ok {
+			names := make([]string, 0, len(module.Options))
+			for name := range module.Options {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			resp.Diagnostics.AddAttributeError(path.Root("options").AtMapKey(key), "Unknown Module Option",
+				fmt.Sprintf("The %s module has no option %q. Available options: %s.", module.Name, key, strings.Join(names, ", ")))
+		}
+	}
+}
+
+func (r *CephMgrModuleResource) refresh(ctx context.Context, data *CephMgrModuleResourceModel) error {
+	name := data.Name.ValueString()
+	module, err := r.client.GetMgrModule(name)
+	if err != nil {
+		return err
+	}
+
+	data.Enabled = types.BoolValue(module.Enabled || module.AlwaysOn)
+	data.AlwaysOn = types.BoolValue(module.AlwaysOn)
+
+	// Only the configured options are tracked
+	if data.Options.IsNull() || data.Options.IsUnknown() {
+		data.Options = types.MapNull(types.StringType)
+		return nil
+	}
+
+	config, err := r.client.GetMgrModuleConfig(name)
+	if err != nil {
+		return err
+	}
+
+	options := map[string]string{}
+	for key := range data.Options.Elements() {
+		if value, ok := config[key]; ok {
+			options[key] = value
+		}
+	}
+	var diags diag.Diagnostics
+	data.Options, diags = types.MapValueFrom(ctx, types.StringType, options)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set options: %v", diags)
+	}
+
+	return nil
+}
+
+// setEnabled enables or disables the module and waits for the change
+func (r *CephMgrModuleResource) setEnabled(ctx context.Context, name string, enabled bool) error {
+	var err error
+	if enabled {
+		err = r.client.EnableMgrModule(name)
+	} else {
+		err = r.client.DisableMgrModule(name)
+	}
+	if err != nil {
+		return err
+	}
+
+	return waitFor(ctx, mgrModuleTimeout, func() (bool, error) {
+		module, err := r.client.GetMgrModule(name)
+		if err != nil {
+			return false, err
+		}
+		return module.Enabled == enabled, nil
+	})
+}
+
+// apply sets the configured options that differ from prior, and resets the
+// options removed from the configuration to their default
+func (r *CephMgrModuleResource) apply(ctx context.Context, data *CephMgrModuleResourceModel, prior types.Map) error {
+	name := data.Name.ValueString()
+
+	desired := map[string]string{}
+	if !data.Options.IsNull() {
+		diags := data.Options.ElementsAs(ctx, &desired, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read options: %v", diags)
+		}
+	}
+	previous := map[string]string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags := prior.ElementsAs(ctx, &previous, false)
+		if diags.HasError() {
+			return fmt.Errorf("unable to read prior options: %v", diags)
+		}
+	}
+
+	changes := map[string]string{}
+	for key, value := range desired {
+		if v, ok := previous[key]; !ok || v != value {
+			changes[key] = value
+		}
+	}
+
+	var removed []string
+	for key := range previous {
+		if _, ok := desired[key]; !ok {
+			removed = append(removed, key)
+		}
+	}
+	if len(removed) > 0 {
+		module, err := r.client.GetMgrModule(name)
+		if err != nil {
+			return err
+		}
+		for _, key := range removed {
+			if option, ok := module.Options[key]; ok {
+				changes[key] = client.RawString(option.DefaultValue)
+			}
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil
+	}
+	return r.client.SetMgrModuleConfig(name, changes)
+}
+
+func (r *CephMgrModuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephMgrModuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	module, err := r.client.GetMgrModule(name)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read manager module: %s", err))
+		return
+	}
+
+	// Options of a disabled module can only be set once it is enabled
+	if data.Enabled.ValueBool() && !module.Enabled && !module.AlwaysOn {
+		err = r.setEnabled(ctx, name, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable manager module: %s", err))
+			return
+		}
+	}
+
+	err = r.apply(ctx, &data, types.MapNull(types.StringType))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set manager module options: %s", err))
+		return
+	}
+
+	if !data.Enabled.ValueBool() && module.Enabled && !module.AlwaysOn {
+		err = r.setEnabled(ctx, name, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable manager module: %s", err))
+			return
+		}
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read manager module: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephMgrModuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephMgrModuleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read manager module: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephMgrModuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CephMgrModuleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	enable := data.Enabled.ValueBool() && !state.Enabled.ValueBool()
+	disable := !data.Enabled.ValueBool() && state.Enabled.ValueBool()
+
+	if enable {
+		err := r.setEnabled(ctx, name, true)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable manager module: %s", err))
+			return
+		}
+	}
+
+	err := r.apply(ctx, &data, state.Options)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set manager module options: %s", err))
+		return
+	}
+
+	if disable {
+		err := r.setEnabled(ctx, name, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable manager module: %s", err))
+			return
+		}
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read manager module: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephMgrModuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Disabling a module (e.g. dashboard, prometheus) on destroy could cut off
+	// its consumers; the resource is only removed from state.
+}
+
+func (r *CephMgrModuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
@@ -0,0 +1,429 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephNFSExportResource{}
+var _ resource.ResourceWithConfigure = &CephNFSExportResource{}
+var _ resource.ResourceWithImportState = &CephNFSExportResource{}
+var _ resource.ResourceWithValidateConfig = &CephNFSExportResource{}
+
+// nfsAccessTypes and nfsSquashModes list the values accepted by NFS-Ganesha
+var nfsAccessTypes = map[string]bool{"RW": true, "RO": true, "NONE": true}
+var nfsSquashModes = map[string]bool{"no_root_squash": true, "root_squash": true, "root_id_squash": true, "all_squash": true}
+
+type CephNFSExportResource struct {
+	client *client.Client
+}
+
+type CephNFSExportResourceModel struct {
+	ClusterID     types.String               `tfsdk:"cluster_id"`
+	Pseudo        types.String               `tfsdk:"pseudo"`
+	Path          types.String               `tfsdk:"path"`
+	FSAL          *CephNFSExportFSALModel    `tfsdk:"fsal"`
+	AccessType    types.String               `tfsdk:"access_type"`
+	Squash        types.String               `tfsdk:"squash"`
+	SecurityLabel types.Bool                 `tfsdk:"security_label"`
+	Protocols     types.List                 `tfsdk:"protocols"`
+	Transports    types.List                 `tfsdk:"transports"`
+	Clients       []CephNFSExportClientModel `tfsdk:"clients"`
+	ExportID      types.Int64                `tfsdk:"export_id"`
+}
+
+type CephNFSExportFSALModel struct {
+	Name   types.String `tfsdk:"name"`
+	FsName types.String `tfsdk:"fs_name"`
+	UserID types.String `tfsdk:"user_id"`
+}
+
+type CephNFSExportClientModel struct {
+	Addresses  types.List   `tfsdk:"addresses"`
+	AccessType types.String `tfsdk:"access_type"`
+	Squash     types.String `tfsdk:"squash"`
+}
+
+func NewCephNFSExportResource() resource.Resource {
+	return &CephNFSExportResource{}
+}
+
+func (r *CephNFSExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nfs_export"
+}
+
+func (r *CephNFSExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an NFS-Ganesha export of a CephFS path or an RGW bucket",
+		Attributes: map[string]schema.Attribute{
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the NFS cluster",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"pseudo": schema.StringAttribute{
+				MarkdownDescription: "The NFSv4 pseudo path of the export (e.g., /projects)",
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "The exported CephFS path, or the bucket name with the RGW FSAL",
+				Required:            true,
+			},
+			"fsal": schema.SingleNestedAttribute{
+				MarkdownDescription: "The backend of the export",
+				Required:            true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						MarkdownDescription: "The FSAL: CEPH (CephFS) or RGW",
+						Required:            true,
+					},
+					"fs_name": schema.StringAttribute{
+						MarkdownDescription: "The CephFS file system, required with the CEPH FSAL",
+						Optional:            true,
+					},
+					"user_id": schema.StringAttribute{
+						MarkdownDescription: "The RGW user accessing the bucket with the RGW FSAL. Defaults to the bucket owner.",
+						Optional:            true,
+					},
+				},
+			},
+			"access_type": schema.StringAttribute{
+				MarkdownDescription: "The access type: RW, RO or NONE. Default: RW.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("RW"),
+			},
+			"squash": schema.StringAttribute{
+				MarkdownDescription: "The user ID squashing: no_root_squash, root_squash, root_id_squash or all_squash. Default: no_root_squash.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("no_root_squash"),
+			},
+			"security_label": schema.BoolAttribute{
+				MarkdownDescription: "Whether to enable security labels (NFSv4.2). Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"protocols": schema.ListAttribute{
+				MarkdownDescription: "The NFS protocol versions (3, 4). Default: [4].",
+				ElementType:         types.Int64Type,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(4)})),
+			},
+			"transports": schema.ListAttribute{
+				MarkdownDescription: "The transport protocols (TCP, UDP). Default: [TCP].",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{types.StringValue("TCP")})),
+			},
+			"clients": schema.ListNestedAttribute{
+				MarkdownDescription: "Client blocks overriding the access settings for specific addresses",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"addresses": schema.ListAttribute{
+							MarkdownDescription: "The client addresses, networks or host names (e.g., 192.168.0.0/24)",
+							ElementType:         types.StringType,
+							Required:            true,
+						},
+						"access_type": schema.StringAttribute{
+							MarkdownDescription: "The access type of the clients. Defaults to the export access type.",
+							Optional:            true,
+						},
+						"squash": schema.StringAttribute{
+							MarkdownDescription: "The user ID squashing of the clients. Defaults to the export squashing.",
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"export_id": schema.Int64Attribute{
+				MarkdownDescription: "The ID of the export in its cluster",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephNFSExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephNFSExportResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephNFSExportResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.FSAL != nil && !data.FSAL.Name.IsUnknown() {
+		switch data.FSAL.Name.ValueString() {
+		case "CEPH":
+			if data.FSAL.FsName.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root("fsal").AtName("fs_name"), "Missing File System", "fs_name is required with the CEPH FSAL.")
+			}
+		case "RGW":
+		default:
+			resp.Diagnostics.AddAttributeError(path.Root("fsal").AtName("name"), "Invalid FSAL", fmt.Sprintf("Expected CEPH or RGW, got: %s", data.FSAL.Name.ValueString()))
+		}
+	}
+
+	validate := func(p path.Path, accessType, squash types.String) {
+		if !accessType.IsNull() && !accessType.IsUnknown() && !nfsAccessTypes[accessType.ValueString()] {
+			resp.Diagnostics.AddAttributeError(p.AtName("access_type"), "Invalid Access Type", fmt.Sprintf("Expected RW, RO or NONE, got: %s", accessType.ValueString()))
+		}
+		if !squash.IsNull() && !squash.IsUnknown() && !nfsSquashModes[squash.ValueString()] {
+			resp.Diagnostics.AddAttributeError(p.AtName("squash"), "Invalid Squash", fmt.Sprintf("Expected no_root_squash, root_squash, root_id_squash or all_squash, got: %s", squash.ValueString()))
+		}
+	}
+	validate(path.Empty(), data.AccessType, data.Squash)
+	for i, c := range data.Clients {
+		validate(path.Root("clients").AtListIndex(i), c.AccessType, c.Squash)
+	}
+}
+
+func (r *CephNFSExportResource) expand(ctx context.Context, data *CephNFSExportResourceModel) (client.NFSExport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	export := client.NFSExport{
+		ExportID:      int(data.ExportID.ValueInt64()),
+		ClusterID:     data.ClusterID.ValueString(),
+		Path:          data.Path.ValueString(),
+		Pseudo:        data.Pseudo.ValueString(),
+		AccessType:    data.AccessType.ValueString(),
+		Squash:        data.Squash.ValueString(),
+		SecurityLabel: data.SecurityLabel.ValueBool(),
+		FSAL: client.NFSExportFSAL{
+			Name:   data.FSAL.Name.ValueString(),
+			FsName: data.FSAL.FsName.ValueString(),
+			UserID: data.FSAL.UserID.ValueString(),
+		},
+		Clients: []client.NFSExportClient{},
+	}
+
+	var protocols []int64
+	diags.Append(data.Protocols.ElementsAs(ctx, &protocols, false)...)
+	for _, p := range protocols {
+		export.Protocols = append(export.Protocols, int(p))
+	}
+	diags.Append(data.Transports.ElementsAs(ctx, &export.Transports, false)...)
+
+	for _, c := range data.Clients {
+		nfsClient := client.NFSExportClient{
+			AccessType: c.AccessType.ValueString(),
+			Squash:     c.Squash.ValueString(),
+		}
+		diags.Append(c.Addresses.ElementsAs(ctx, &nfsClient.Addresses, false)...)
+		export.Clients = append(export.Clients, nfsClient)
+	}
+
+	return export, diags
+}
+
+func (r *CephNFSExportResource) refresh(ctx context.Context, data *CephNFSExportResourceModel) error {
+	export, err := r.client.GetNFSExport(data.ClusterID.ValueString(), int(data.ExportID.ValueInt64()))
+	if err != nil {
+		return err
+	}
+
+	data.Path = types.StringValue(export.Path)
+	data.Pseudo = types.StringValue(export.Pseudo)
+	data.AccessType = types.StringValue(export.AccessType)
+	data.Squash = types.StringValue(export.Squash)
+	data.SecurityLabel = types.BoolValue(export.SecurityLabel)
+	fsal := &CephNFSExportFSALModel{
+		Name:   types.StringValue(export.FSAL.Name),
+		FsName: optionalString(export.FSAL.FsName),
+		UserID: optionalString(export.FSAL.UserID),
+	}
+	// The RGW FSAL reports the bucket owner when no user is configured
+	if data.FSAL != nil && data.FSAL.UserID.IsNull() {
+		fsal.UserID = types.StringNull()
+	}
+	data.FSAL = fsal
+
+	protocols := make([]int64, 0, len(export.Protocols))
+	for _, p := range export.Protocols {
+		protocols = append(protocols, int64(p))
+	}
+	var diags diag.Diagnostics
+	data.Protocols, diags = types.ListValueFrom(ctx, types.Int64Type, protocols)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set protocols: %v", diags)
+	}
+	data.Transports, diags = types.ListValueFrom(ctx, types.StringType, export.Transports)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set transports: %v", diags)
+	}
+
+	var clients []CephNFSExportClientModel
+	for _, c := range export.Clients {
+		addresses, diags := types.ListValueFrom(ctx, types.StringType, c.Addresses)
+		if diags.HasError() {
+			return fmt.Errorf("unable to set client addresses: %v", diags)
+		}
+		clients = append(clients, CephNFSExportClientModel{
+			Addresses:  addresses,
+			AccessType: optionalString(c.AccessType),
+			Squash:     optionalString(c.Squash),
+		})
+	}
+	data.Clients = clients
+
+	return nil
+}
+
+// optionalString converts an API string into a state value, where an empty string is null
+func optionalString(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func (r *CephNFSExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephNFSExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	export, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := r.client.CreateNFSExport(export)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NFS export: %s", err))
+		return
+	}
+	data.ExportID = types.Int64Value(int64(created.ExportID))
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created NFS export: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephNFSExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephNFSExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NFS export: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephNFSExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephNFSExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	export, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateNFSExport(export)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NFS export: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated NFS export: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephNFSExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephNFSExportResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteNFSExport(data.ClusterID.ValueString(), int(data.ExportID.ValueInt64()))
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NFS export: %s", err))
+		return
+	}
+}
+
+func (r *CephNFSExportResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterID, id, ok := strings.Cut(req.ID, ":")
+	exportID, err := strconv.ParseInt(id, 10, 64)
+	if !ok || clusterID == "" || err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <cluster_id>:<export_id> (e.g., nfs-a:1), got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("cluster_id"), clusterID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("export_id"), exportID)...)
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephOrchServiceResource{}
+var _ resource.ResourceWithConfigure = &CephOrchServiceResource{}
+var _ resource.ResourceWithImportState = &CephOrchServiceResource{}
+var _ resource.ResourceWithValidateConfig = &CephOrchServiceResource{}
+
+// orchServiceTimeout bounds the wait for the orchestrator to deploy or remove daemons
+const orchServiceTimeout = 10 * time.Minute
+
+// orchServiceTypesWithID lists the service types that require a service ID
+var orchServiceTypesWithID = map[string]bool{"mds": true, "rgw": true, "nfs": true, "ingress": true, "iscsi": true, "nvmeof": true}
+
+type CephOrchServiceResource struct {
+	client *client.Client
+}
+
+type CephOrchServiceResourceModel struct {
+	ServiceType types.String        `tfsdk:"service_type"`
+	ServiceID   types.String        `tfsdk:"service_id"`
+	Placement   *CephPlacementModel `tfsdk:"placement"`
+	Unmanaged   types.Bool          `tfsdk:"unmanaged"`
+	Spec        types.String        `tfsdk:"spec"`
+	ServiceName types.String        `tfsdk:"service_name"`
+	Running     types.Int64         `tfsdk:"running"`
+	Size        types.Int64         `tfsdk:"size"`
+}
+
+func NewCephOrchServiceResource() resource.Resource {
+	return &CephOrchServiceResource{}
+}
+
+func (r *CephOrchServiceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orch_service"
+}
+
+func (r *CephOrchServiceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an orchestrator (cephadm) service, equivalent to `ceph orch apply`. " +
+			"Changes wait until the orchestrator has deployed the requested daemons.",
+		Attributes: map[string]schema.Attribute{
+			"service_type": schema.StringAttribute{
+				MarkdownDescription: "The service type (e.g., mds, rgw, nfs, ingress, crash, node-exporter)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The service ID, required for mds, rgw, nfs, ingress, iscsi and nvmeof services",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement": placementAttribute("Where the orchestrator deploys the daemons of the service"),
+			"unmanaged": schema.BoolAttribute{
+				MarkdownDescription: "Whether the orchestrator leaves the daemons of the service alone. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"spec": schema.StringAttribute{
+				MarkdownDescription: "The service type specific settings as a JSON object (e.g., `jsonencode({ rgw_frontend_port = 8080 })`). " +
+					"Only the configured settings are compared with the cluster.",
+				Optional: true,
+			},
+			"service_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the service (e.g., rgw.default)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"running": schema.Int64Attribute{
+				MarkdownDescription: "The number of running daemons",
+				Computed:            true,
+			},
+			"size": schema.Int64Attribute{
+				MarkdownDescription: "The number of daemons the orchestrator deploys",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CephOrchServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephOrchServiceResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephOrchServiceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ServiceType.IsUnknown() && orchServiceTypesWithID[data.ServiceType.ValueString()] && data.ServiceID.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("service_id"), "Missing Service ID",
+			fmt.Sprintf("%s services require a service_id.", data.ServiceType.ValueString()))
+	}
+
+	if !data.Spec.IsNull() && !data.Spec.IsUnknown() {
+		var spec map[string]interface{}
+		if err := json.Unmarshal([]byte(data.Spec.ValueString()), &spec); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("spec"), "Invalid Spec", fmt.Sprintf("The spec must be a JSON object: %s", err))
+		}
+	}
+}
+
+// jsonSubset reports whether all settings of the JSON object expected are set
+// to the same values in actual
+func jsonSubset(expected string, actual map[string]interface{}) bool {
+	var want map[string]interface{}
+	if json.Unmarshal([]byte(expected), &want) != nil {
+		return false
+	}
+
+	// Normalize the actual values to their JSON decoded form
+	rb, err := json.Marshal(actual)
+	if err != nil {
+		return false
+	}
+	var got map[string]interface{}
+	if json.Unmarshal(rb, &got) != nil {
+		return false
+	}
+
+	for k, v := range want {
+		if !reflect.DeepEqual(got[k], v) {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *CephOrchServiceResource) expand(ctx context.Context, data *CephOrchServiceResourceModel) (client.ServiceSpec, error) {
+	placement, diags := expandPlacement(ctx, data.Placement)
+	if diags.HasError() {
+		return client.ServiceSpec{}, fmt.Errorf("unable to read placement: %v", diags)
+	}
+
+	spec := client.ServiceSpec{
+		ServiceType: data.ServiceType.ValueString(),
+		ServiceID:   data.ServiceID.ValueString(),
+		Placement:   placement,
+		Unmanaged:   data.Unmanaged.ValueBool(),
+	}
+	if !data.Spec.IsNull() {
+		if err := json.Unmarshal([]byte(data.Spec.ValueString()), &spec.Spec); err != nil {
+			return spec, fmt.Errorf("invalid spec: %w", err)
+		}
+	}
+
+	return spec, nil
+}
+
+func (r *CephOrchServiceResource) refresh(ctx context.Context, data *CephOrchServiceResourceModel) error {
+	service, err := r.client.GetService(data.ServiceName.ValueString())
+	if err != nil {
+		return err
+	}
+
+	placement, diags := flattenPlacement(ctx, service.Placement)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set placement: %v", diags)
+	}
+	data.Placement = placement
+	data.Unmanaged = types.BoolValue(service.Unmanaged)
+	data.Running = types.Int64Value(int64(service.Status.Running))
+	data.Size = types.Int64Value(int64(service.Status.Size))
+
+	// The API reports every setting including defaults, so only configured settings are compared
+	if !data.Spec.IsNull() && !jsonSubset(data.Spec.ValueString(), service.Spec) {
+		rb, err := json.Marshal(service.Spec)
+		if err != nil {
+			return err
+		}
+		data.Spec = types.StringValue(string(rb))
+	}
+
+	return nil
+}
+
+// apply submits the service specification and waits until the service has converged
+func (r *CephOrchServiceResource) apply(ctx context.Context, data *CephOrchServiceResourceModel) error {
+	spec, err := r.expand(ctx, data)
+	if err != nil {
+		return err
+	}
+
+	err = r.client.ApplyServiceSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	if spec.Unmanaged {
+		return nil
+	}
+
+	return waitFor(ctx, orchServiceTimeout, func() (bool, error) {
+		service, err := r.client.GetService(data.ServiceName.ValueString())
+		if errors.Is(err, client.ErrNotFound) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return service.Status.Size > 0 && service.Status.Running == service.Status.Size, nil
+	})
+}
+
+func (r *CephOrchServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephOrchServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ServiceName = types.StringValue(client.ServiceName(data.ServiceType.ValueString(), data.ServiceID.ValueString()))
+
+	err := r.apply(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply orchestrator service: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read applied orchestrator service: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOrchServiceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephOrchServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read orchestrator service: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOrchServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephOrchServiceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply orchestrator service: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read applied orchestrator service: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOrchServiceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephOrchServiceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.ServiceName.ValueString()
+	err := r.client.DeleteService(name)
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete orchestrator service: %s", err))
+		return
+	}
+
+	err = waitFor(ctx, orchServiceTimeout, func() (bool, error) {
+		_, err := r.client.GetService(name)
+		if errors.Is(err, client.ErrNotFound) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for the removal of orchestrator service %s: %s", name, err))
+		return
+	}
+}
+
+func (r *CephOrchServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	serviceType, serviceID, _ := strings.Cut(req.ID, ".")
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_type"), serviceType)...)
+	if serviceID != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_id"), serviceID)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("service_name"), req.ID)...)
+}
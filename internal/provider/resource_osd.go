@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephOsdResource{}
+var _ resource.ResourceWithConfigure = &CephOsdResource{}
+var _ resource.ResourceWithImportState = &CephOsdResource{}
+
+// osdRemovalTimeout bounds the wait for the orchestrator to remove an OSD
+const osdRemovalTimeout = 30 * time.Minute
+
+type CephOsdResource struct {
+	client *client.Client
+}
+
+type CephOsdResourceModel struct {
+	OsdID    types.Int64  `tfsdk:"osd_id"`
+	Out      types.Bool   `tfsdk:"out"`
+	Destroy  types.Bool   `tfsdk:"destroy"`
+	Replace  types.Bool   `tfsdk:"replace"`
+	UUID     types.String `tfsdk:"uuid"`
+	Up       types.Bool   `tfsdk:"up"`
+	Hostname types.String `tfsdk:"hostname"`
+	Devices  types.String `tfsdk:"devices"`
+}
+
+func NewCephOsdResource() resource.Resource {
+	return &CephOsdResource{}
+}
+
+func (r *CephOsdResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_osd"
+}
+
+func (r *CephOsdResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the lifecycle of an existing OSD, typically deployed from a `ceph_osd_spec`: " +
+			"marking it out and removing it once Ceph reports it safe to destroy.",
+		Attributes: map[string]schema.Attribute{
+			"osd_id": schema.Int64Attribute{
+				MarkdownDescription: "The OSD number (e.g., 3 for osd.3)",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"out": schema.BoolAttribute{
+				MarkdownDescription: "Whether the OSD is marked out, i.e. its data is migrated to other OSDs. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying this resource removes the OSD from the cluster. " +
+					"The removal is refused unless Ceph reports the OSD safe to destroy, so mark it `out` and let the data migrate first. " +
+					"When false, the OSD is only removed from the Terraform state. Default: false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"replace": schema.BoolAttribute{
+				MarkdownDescription: "Whether the removal keeps the OSD ID and CRUSH position (the OSD is marked destroyed) " +
+					"for the OSD deployed on the replacement device. Default: false.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The OSD UUID",
+				Computed:            true,
+			},
+			"up": schema.BoolAttribute{
+				MarkdownDescription: "Whether the OSD is up",
+				Computed:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The host running the OSD",
+				Computed:            true,
+			},
+			"devices": schema.StringAttribute{
+				MarkdownDescription: "The devices backing the OSD (e.g., sdb)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CephOsdResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephOsdResource) refresh(data *CephOsdResourceModel) error {
+	osd, err := r.client.GetOsd(int(data.OsdID.ValueInt64()))
+	if err != nil {
+		return err
+	}
+
+	data.Out = types.BoolValue(osd.OsdMap.In == 0)
+	data.UUID = types.StringValue(osd.OsdMap.UUID)
+	data.Up = types.BoolValue(osd.OsdMap.Up == 1)
+	data.Hostname = types.StringValue(osd.Metadata.Hostname)
+	data.Devices = types.StringValue(osd.Metadata.Devices)
+	if data.Destroy.IsNull() {
+		data.Destroy = types.BoolValue(false)
+	}
+	if data.Replace.IsNull() {
+		data.Replace = types.BoolValue(false)
+	}
+
+	return nil
+}
+
+// setOut marks the OSD out or in
+func (r *CephOsdResource) setOut(id int, out bool) error {
+	action := "in"
+	if out {
+		action = "out"
+	}
+	return r.client.MarkOsd(id, action)
+}
+
+func (r *CephOsdResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephOsdResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(data.OsdID.ValueInt64())
+	osd, err := r.client.GetOsd(id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read osd.%d: %s", id, err))
+		return
+	}
+
+	if (osd.OsdMap.In == 0) != data.Out.ValueBool() {
+		err = r.setOut(id, data.Out.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to mark osd.%d: %s", id, err))
+			return
+		}
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read osd.%d: %s", id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephOsdResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(&data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CephOsdResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := int(data.OsdID.ValueInt64())
+	if !data.Out.Equal(state.Out) {
+		err := r.setOut(id, data.Out.ValueBool())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to mark osd.%d: %s", id, err))
+			return
+		}
+	}
+
+	err := r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read osd.%d: %s", id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephOsdResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Destroy.ValueBool() {
+		// The OSD is kept in the cluster; the resource is only removed from state.
+		return
+	}
+
+	id := int(data.OsdID.ValueInt64())
+	check, err := r.client.OsdSafeToDestroyCheck(id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check whether osd.%d is safe to destroy: %s", id, err))
+		return
+	}
+	if !check.IsSafeToDestroy {
+		detail := fmt.Sprintf("osd.%d was not removed because Ceph does not report it safe to destroy", id)
+		if check.Message != "" {
+			detail += ": " + check.Message
+		}
+		resp.Diagnostics.AddError("OSD Not Safe To Destroy",
+			detail+". Set out = true, apply and wait for its placement groups to migrate before destroying it.")
+		return
+	}
+
+	replace := data.Replace.ValueBool()
+	err = r.client.RemoveOsd(id, replace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove osd.%d: %s", id, err))
+		return
+	}
+
+	err = waitFor(ctx, osdRemovalTimeout, func() (bool, error) {
+		osd, err := r.client.GetOsd(id)
+		if errors.Is(err, client.ErrNotFound) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		return replace && osd.OsdMap.Destroyed(), nil
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to wait for the removal of osd.%d: %s", id, err))
+		return
+	}
+}
+
+func (r *CephOsdResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected an OSD number (e.g., 3), got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("osd_id"), id)...)
+}
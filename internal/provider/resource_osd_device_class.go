@@ -0,0 +1,181 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephOsdDeviceClassResource{}
+var _ resource.ResourceWithConfigure = &CephOsdDeviceClassResource{}
+var _ resource.ResourceWithImportState = &CephOsdDeviceClassResource{}
+var _ resource.ResourceWithModifyPlan = &CephOsdDeviceClassResource{}
+
+type CephOsdDeviceClassResource struct {
+	client *client.Client
+}
+
+type CephOsdDeviceClassResourceModel struct {
+	OsdID       types.Int64  `tfsdk:"osd_id"`
+	DeviceClass types.String `tfsdk:"device_class"`
+}
+
+func NewCephOsdDeviceClassResource() resource.Resource {
+	return &CephOsdDeviceClassResource{}
+}
+
+func (r *CephOsdDeviceClassResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_osd_device_class"
+}
+
+func (r *CephOsdDeviceClassResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the CRUSH device class of an OSD. Destroying this resource leaves the current device class in place.",
+		Attributes: map[string]schema.Attribute{
+			"osd_id": schema.Int64Attribute{
+				MarkdownDescription: "The OSD number (e.g., 3 for osd.3)",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"device_class": schema.StringAttribute{
+				MarkdownDescription: "The device class to assign (e.g., hdd, ssd, nvme)",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *CephOsdDeviceClassResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephOsdDeviceClassResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan CephOsdDeviceClassResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() || plan.DeviceClass.IsUnknown() || plan.OsdID.IsUnknown() {
+		return
+	}
+
+	current := ""
+	if req.State.Raw.IsNull() {
+		// On create, compare against the class currently assigned on the cluster
+		if r.client == nil {
+			return
+		}
+		node, err := r.client.GetCrushNode(fmt.Sprintf("osd.%d", plan.OsdID.ValueInt64()))
+		if err != nil {
+			return
+		}
+		current = node.DeviceClass
+	} else {
+		var state CephOsdDeviceClassResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		current = state.DeviceClass.ValueString()
+	}
+
+	if current != plan.DeviceClass.ValueString() {
+		resp.Diagnostics.AddAttributeWarning(path.Root("device_class"), "Device Class Change Triggers Rebalance",
+			fmt.Sprintf("Changing the device class of osd.%d from %q to %q moves it between CRUSH shadow trees. "+
+				"Pools whose rules select a device class will rebalance data onto or off this OSD.",
+				plan.OsdID.ValueInt64(), current, plan.DeviceClass.ValueString()))
+	}
+}
+
+func (r *CephOsdDeviceClassResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephOsdDeviceClassResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetOsdDeviceClass(int(data.OsdID.ValueInt64()), data.DeviceClass.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set OSD device class: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdDeviceClassResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephOsdDeviceClassResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	node, err := r.client.GetCrushNode(fmt.Sprintf("osd.%d", data.OsdID.ValueInt64()))
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD: %s", err))
+		return
+	}
+
+	data.DeviceClass = types.StringValue(node.DeviceClass)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdDeviceClassResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephOsdDeviceClassResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetOsdDeviceClass(int(data.OsdID.ValueInt64()), data.DeviceClass.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set OSD device class: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdDeviceClassResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The OSD keeps its current device class; the resource is only removed from state.
+}
+
+func (r *CephOsdDeviceClassResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected an OSD number (e.g., 3), got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("osd_id"), id)...)
+}
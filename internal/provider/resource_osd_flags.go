@@ -0,0 +1,218 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephOsdFlagsResource{}
+var _ resource.ResourceWithConfigure = &CephOsdFlagsResource{}
+var _ resource.ResourceWithImportState = &CephOsdFlagsResource{}
+var _ resource.ResourceWithValidateConfig = &CephOsdFlagsResource{}
+
+type CephOsdFlagsResource struct {
+	client *client.Client
+}
+
+type CephOsdFlagsResourceModel struct {
+	Flags types.List `tfsdk:"flags"`
+}
+
+func NewCephOsdFlagsResource() resource.Resource {
+	return &CephOsdFlagsResource{}
+}
+
+func (r *CephOsdFlagsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_osd_flags"
+}
+
+func (r *CephOsdFlagsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the cluster-wide OSD flags (e.g., `noout` during a maintenance window). " +
+			"Only one instance should exist per cluster; destroying it unsets the flags it manages. " +
+			"Permanent flags such as `sortbitwise` are left alone.",
+		Attributes: map[string]schema.Attribute{
+			"flags": schema.ListAttribute{
+				MarkdownDescription: "The flags to set: " + strings.Join(client.OsdSettableFlags, ", ") + ". " +
+					"Flags not listed are unset.",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *CephOsdFlagsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephOsdFlagsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephOsdFlagsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() || data.Flags.IsUnknown() {
+		return
+	}
+
+	for i, flag := range data.Flags.Elements() {
+		s, ok := flag.(types.String)
+		if !ok || s.IsUnknown() || s.IsNull() {
+			continue
+		}
+		if !slices.Contains(client.OsdSettableFlags, s.ValueString()) {
+			resp.Diagnostics.AddAttributeError(path.Root("flags").AtListIndex(i), "Invalid OSD Flag",
+				fmt.Sprintf("%q is not a settable OSD flag. Expected one of: %s.", s.ValueString(), strings.Join(client.OsdSettableFlags, ", ")))
+		}
+	}
+}
+
+func (r *CephOsdFlagsResource) refresh(ctx context.Context, data *CephOsdFlagsResourceModel) error {
+	flags, err := r.client.GetOsdFlags()
+	if err != nil {
+		return err
+	}
+
+	list, diags := flattenUnorderedList(ctx, data.Flags, client.SettableOsdFlags(flags))
+	if diags.HasError() {
+		return fmt.Errorf("unable to set flags: %v", diags)
+	}
+	data.Flags = list
+
+	return nil
+}
+
+// apply sets the given flags and unsets the other settable flags
+func (r *CephOsdFlagsResource) apply(ctx context.Context, data *CephOsdFlagsResourceModel) error {
+	var desired []string
+	diags := data.Flags.ElementsAs(ctx, &desired, false)
+	if diags.HasError() {
+		return fmt.Errorf("unable to read flags: %v", diags)
+	}
+
+	current, err := r.client.GetOsdFlags()
+	if err != nil {
+		return err
+	}
+
+	return r.client.SetOsdFlags(client.MergeOsdFlags(current, desired))
+}
+
+func (r *CephOsdFlagsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephOsdFlagsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set OSD flags: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD flags: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdFlagsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephOsdFlagsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD flags: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdFlagsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephOsdFlagsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set OSD flags: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD flags: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdFlagsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephOsdFlagsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var managed []string
+	resp.Diagnostics.Append(data.Flags.ElementsAs(ctx, &managed, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.client.GetOsdFlags()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD flags: %s", err))
+		return
+	}
+
+	// Keep the settable flags that were set outside of this resource
+	remaining := []string{}
+	for _, flag := range client.SettableOsdFlags(current) {
+		if !slices.Contains(managed, flag) {
+			remaining = append(remaining, flag)
+		}
+	}
+
+	err = r.client.SetOsdFlags(client.MergeOsdFlags(current, remaining))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unset OSD flags: %s", err))
+		return
+	}
+}
+
+func (r *CephOsdFlagsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// The flags are cluster-wide, so any import ID is accepted; Read fills in the current flags
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("flags"), types.ListValueMust(types.StringType, []attr.Value{}))...)
+}
@@ -0,0 +1,276 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephOsdSettingsResource{}
+var _ resource.ResourceWithConfigure = &CephOsdSettingsResource{}
+var _ resource.ResourceWithImportState = &CephOsdSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &CephOsdSettingsResource{}
+
+type CephOsdSettingsResource struct {
+	client *client.Client
+}
+
+type CephOsdSettingsResourceModel struct {
+	OsdID       types.Int64   `tfsdk:"osd_id"`
+	Reweight    types.Float64 `tfsdk:"reweight"`
+	DeviceClass types.String  `tfsdk:"device_class"`
+	Noout       types.Bool    `tfsdk:"noout"`
+}
+
+func NewCephOsdSettingsResource() resource.Resource {
+	return &CephOsdSettingsResource{}
+}
+
+func (r *CephOsdSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_osd_settings"
+}
+
+func (r *CephOsdSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the settings of an OSD. Settings that are not configured are left unchanged. " +
+			"Destroying this resource unsets the `noout` flag of the OSD and leaves the other settings in place. " +
+			"Do not manage the device class of an OSD with both this resource and `ceph_osd_device_class`. " +
+			"Primary affinity is not managed, as the Ceph Dashboard API has no endpoint to set it; it is reported by the `ceph_osd_tree` data source.",
+		Attributes: map[string]schema.Attribute{
+			"osd_id": schema.Int64Attribute{
+				MarkdownDescription: "The OSD number (e.g., 3 for osd.3)",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"reweight": schema.Float64Attribute{
+				MarkdownDescription: "The reweight value (0 to 1) overriding the CRUSH weight of the OSD",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Float64{
+					float64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_class": schema.StringAttribute{
+				MarkdownDescription: "The CRUSH device class (e.g., hdd, ssd, nvme)",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"noout": schema.BoolAttribute{
+				MarkdownDescription: "Whether the OSD is never marked out automatically while it is down",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephOsdSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephOsdSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephOsdSettingsResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Reweight.IsNull() || data.Reweight.IsUnknown() {
+		return
+	}
+	if v := data.Reweight.ValueFloat64(); v < 0 || v > 1 {
+		resp.Diagnostics.AddAttributeError(path.Root("reweight"), "Invalid Value",
+			fmt.Sprintf("reweight must be between 0 and 1, got: %g", v))
+	}
+}
+
+// flattenRatio keeps the prior value when it matches the value reported by
+// Ceph, which stores ratios as 16.16 fixed point numbers (e.g. 0.8 reads back as 0.79999)
+func flattenRatio(prior types.Float64, value float64) types.Float64 {
+	if !prior.IsNull() && !prior.IsUnknown() && math.Abs(prior.ValueFloat64()-value) < 1e-4 {
+		return prior
+	}
+	return types.Float64Value(value)
+}
+
+func (r *CephOsdSettingsResource) refresh(data *CephOsdSettingsResourceModel) error {
+	id := int(data.OsdID.ValueInt64())
+	osd, err := r.client.GetOsd(id)
+	if err != nil {
+		return err
+	}
+
+	node, err := r.client.GetCrushNode(fmt.Sprintf("osd.%d", id))
+	if err != nil {
+		return err
+	}
+
+	flags, err := r.client.GetOsdIndividualFlags(id)
+	if err != nil {
+		return err
+	}
+
+	data.Reweight = flattenRatio(data.Reweight, osd.OsdMap.Weight)
+	data.DeviceClass = types.StringValue(node.DeviceClass)
+	data.Noout = types.BoolValue(slices.Contains(flags, "noout"))
+
+	return nil
+}
+
+// apply changes the configured settings that differ from state; state is nil on create
+func (r *CephOsdSettingsResource) apply(data, state *CephOsdSettingsResourceModel) error {
+	id := int(data.OsdID.ValueInt64())
+
+	if !data.Reweight.IsUnknown() && (state == nil || !data.Reweight.Equal(state.Reweight)) {
+		err := r.client.ReweightOsd(id, data.Reweight.ValueFloat64())
+		if err != nil {
+			return fmt.Errorf("unable to reweight: %w", err)
+		}
+	}
+
+	if !data.DeviceClass.IsUnknown() && (state == nil || !data.DeviceClass.Equal(state.DeviceClass)) {
+		err := r.client.SetOsdDeviceClass(id, data.DeviceClass.ValueString())
+		if err != nil {
+			return fmt.Errorf("unable to set device class: %w", err)
+		}
+	}
+
+	if !data.Noout.IsUnknown() && (state == nil || !data.Noout.Equal(state.Noout)) {
+		err := r.client.SetOsdIndividualFlag(id, "noout", data.Noout.ValueBool())
+		if err != nil {
+			return fmt.Errorf("unable to set noout flag: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *CephOsdSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephOsdSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(&data, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply settings of osd.%d: %s", data.OsdID.ValueInt64(), err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings of osd.%d: %s", data.OsdID.ValueInt64(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephOsdSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(&data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD settings: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state CephOsdSettingsResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(&data, &state)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply settings of osd.%d: %s", data.OsdID.ValueInt64(), err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read settings of osd.%d: %s", data.OsdID.ValueInt64(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephOsdSettingsResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A lingering noout flag would keep a failed OSD in, so it is unset; the
+	// other settings are left in place
+	if !data.Noout.ValueBool() {
+		return
+	}
+
+	err := r.client.SetOsdIndividualFlag(int(data.OsdID.ValueInt64()), "noout", false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unset noout flag of osd.%d: %s", data.OsdID.ValueInt64(), err))
+		return
+	}
+}
+
+func (r *CephOsdSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected an OSD number (e.g., 3), got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("osd_id"), id)...)
+}
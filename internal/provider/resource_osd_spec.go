@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephOsdSpecResource{}
+var _ resource.ResourceWithConfigure = &CephOsdSpecResource{}
+var _ resource.ResourceWithImportState = &CephOsdSpecResource{}
+var _ resource.ResourceWithValidateConfig = &CephOsdSpecResource{}
+
+type CephOsdSpecResource struct {
+	client *client.Client
+}
+
+type CephOsdSpecResourceModel struct {
+	ServiceID     types.String              `tfsdk:"service_id"`
+	Placement     *CephPlacementModel       `tfsdk:"placement"`
+	DataDevices   *CephOsdDeviceFilterModel `tfsdk:"data_devices"`
+	DBDevices     *CephOsdDeviceFilterModel `tfsdk:"db_devices"`
+	WALDevices    *CephOsdDeviceFilterModel `tfsdk:"wal_devices"`
+	Encrypted     types.Bool                `tfsdk:"encrypted"`
+	OsdsPerDevice types.Int64               `tfsdk:"osds_per_device"`
+	Unmanaged     types.Bool                `tfsdk:"unmanaged"`
+	ServiceName   types.String              `tfsdk:"service_name"`
+	Running       types.Int64               `tfsdk:"running"`
+}
+
+// CephOsdDeviceFilterModel describes the device selection of an OSD specification
+type CephOsdDeviceFilterModel struct {
+	All        types.Bool   `tfsdk:"all"`
+	Paths      types.List   `tfsdk:"paths"`
+	Rotational types.Bool   `tfsdk:"rotational"`
+	Size       types.String `tfsdk:"size"`
+	Model      types.String `tfsdk:"model"`
+	Vendor     types.String `tfsdk:"vendor"`
+	Limit      types.Int64  `tfsdk:"limit"`
+}
+
+func NewCephOsdSpecResource() resource.Resource {
+	return &CephOsdSpecResource{}
+}
+
+func (r *CephOsdSpecResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_osd_spec"
+}
+
+// osdDeviceFilterAttribute returns the schema of a device selection block
+func osdDeviceFilterAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"all": schema.BoolAttribute{
+				MarkdownDescription: "Select all available devices",
+				Optional:            true,
+			},
+			"paths": schema.ListAttribute{
+				MarkdownDescription: "Select these device paths (e.g., /dev/sdb)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"rotational": schema.BoolAttribute{
+				MarkdownDescription: "Select only rotational (true) or only solid state (false) devices",
+				Optional:            true,
+			},
+			"size": schema.StringAttribute{
+				MarkdownDescription: "Select devices by size: an exact size (e.g., `10G`) or a range (e.g., `10G:40G`, `:1T`, `4T:`)",
+				Optional:            true,
+			},
+			"model": schema.StringAttribute{
+				MarkdownDescription: "Select devices whose model contains this string",
+				Optional:            true,
+			},
+			"vendor": schema.StringAttribute{
+				MarkdownDescription: "Select devices whose vendor contains this string",
+				Optional:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of devices to select per host",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *CephOsdSpecResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an OSD specification (drive group). The orchestrator deploys OSDs on the matching devices of the matching hosts. " +
+			"Destroying this resource only removes the specification; the deployed OSDs are kept.",
+		Attributes: map[string]schema.Attribute{
+			"service_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the specification (e.g., hdd for the osd.hdd service)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"placement":    placementAttribute("The hosts to deploy OSDs on"),
+			"data_devices": osdDeviceFilterAttribute("The devices holding the OSD data"),
+			"db_devices":   osdDeviceFilterAttribute("The devices holding the BlueStore DB (RocksDB)"),
+			"wal_devices":  osdDeviceFilterAttribute("The devices holding the BlueStore WAL"),
+			"encrypted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the OSDs are encrypted with dm-crypt. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"osds_per_device": schema.Int64Attribute{
+				MarkdownDescription: "The number of OSDs deployed per data device (e.g., 2 for fast NVMe devices)",
+				Optional:            true,
+			},
+			"unmanaged": schema.BoolAttribute{
+				MarkdownDescription: "Whether the orchestrator stops deploying OSDs from this specification. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"service_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the OSD service (e.g., osd.hdd)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"running": schema.Int64Attribute{
+				MarkdownDescription: "The number of running OSDs deployed from this specification",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CephOsdSpecResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephOsdSpecResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephOsdSpecResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Placement == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("placement"), "Missing Placement",
+			"An OSD specification requires a placement selecting the hosts to deploy OSDs on.")
+	}
+	if data.DataDevices == nil {
+		resp.Diagnostics.AddAttributeError(path.Root("data_devices"), "Missing Data Devices",
+			"An OSD specification requires data_devices.")
+	}
+}
+
+// expandOsdDeviceFilter converts the device selection model into a client device filter
+func expandOsdDeviceFilter(ctx context.Context, filter *CephOsdDeviceFilterModel) (*client.OsdDeviceFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if filter == nil {
+		return nil, diags
+	}
+
+	result := &client.OsdDeviceFilter{
+		All:        filter.All.ValueBool(),
+		Rotational: filter.Rotational.ValueBoolPointer(),
+		Size:       filter.Size.ValueString(),
+		Model:      filter.Model.ValueString(),
+		Vendor:     filter.Vendor.ValueString(),
+		Limit:      int(filter.Limit.ValueInt64()),
+	}
+	if !filter.Paths.IsNull() {
+		diags.Append(filter.Paths.ElementsAs(ctx, &result.Paths, false)...)
+	}
+
+	return result, diags
+}
+
+// flattenOsdDeviceFilter converts a client device filter into the device selection model
+func flattenOsdDeviceFilter(ctx context.Context, filter *client.OsdDeviceFilter) (*CephOsdDeviceFilterModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if filter == nil {
+		return nil, diags
+	}
+
+	result := &CephOsdDeviceFilterModel{
+		All:        types.BoolNull(),
+		Paths:      types.ListNull(types.StringType),
+		Rotational: types.BoolPointerValue(filter.Rotational),
+		Size:       optionalString(filter.Size),
+		Model:      optionalString(filter.Model),
+		Vendor:     optionalString(filter.Vendor),
+		Limit:      types.Int64Null(),
+	}
+	if filter.All {
+		result.All = types.BoolValue(true)
+	}
+	if len(filter.Paths) > 0 {
+		result.Paths, diags = types.ListValueFrom(ctx, types.StringType, filter.Paths)
+	}
+	if filter.Limit > 0 {
+		result.Limit = types.Int64Value(int64(filter.Limit))
+	}
+
+	return result, diags
+}
+
+func (r *CephOsdSpecResource) expand(ctx context.Context, data *CephOsdSpecResourceModel) (client.OsdSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	spec := client.OsdSpec{
+		ServiceID: data.ServiceID.ValueString(),
+		Unmanaged: data.Unmanaged.ValueBool(),
+		Spec: client.OsdSpecSettings{
+			Encrypted:     data.Encrypted.ValueBool(),
+			OsdsPerDevice: int(data.OsdsPerDevice.ValueInt64()),
+		},
+	}
+
+	var d diag.Diagnostics
+	spec.Placement, d = expandPlacement(ctx, data.Placement)
+	diags.Append(d...)
+	spec.Spec.DataDevices, d = expandOsdDeviceFilter(ctx, data.DataDevices)
+	diags.Append(d...)
+	spec.Spec.DBDevices, d = expandOsdDeviceFilter(ctx, data.DBDevices)
+	diags.Append(d...)
+	spec.Spec.WALDevices, d = expandOsdDeviceFilter(ctx, data.WALDevices)
+	diags.Append(d...)
+
+	return spec, diags
+}
+
+func (r *CephOsdSpecResource) refresh(ctx context.Context, data *CephOsdSpecResourceModel) error {
+	spec, err := r.client.GetOsdSpec(data.ServiceID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var diags, d diag.Diagnostics
+	data.Placement, d = flattenPlacement(ctx, spec.Placement)
+	diags.Append(d...)
+	data.DataDevices, d = flattenOsdDeviceFilter(ctx, spec.Spec.DataDevices)
+	diags.Append(d...)
+	data.DBDevices, d = flattenOsdDeviceFilter(ctx, spec.Spec.DBDevices)
+	diags.Append(d...)
+	data.WALDevices, d = flattenOsdDeviceFilter(ctx, spec.Spec.WALDevices)
+	diags.Append(d...)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set OSD specification: %v", diags)
+	}
+
+	data.Encrypted = types.BoolValue(spec.Spec.Encrypted)
+	data.OsdsPerDevice = types.Int64Null()
+	if spec.Spec.OsdsPerDevice > 0 {
+		data.OsdsPerDevice = types.Int64Value(int64(spec.Spec.OsdsPerDevice))
+	}
+	data.Unmanaged = types.BoolValue(spec.Unmanaged)
+	data.ServiceName = types.StringValue(client.OsdSpecServiceName(spec.ServiceID))
+	data.Running = types.Int64Value(int64(spec.Status.Running))
+
+	return nil
+}
+
+func (r *CephOsdSpecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephOsdSpecResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ApplyOsdSpec(spec)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply OSD specification: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read applied OSD specification: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdSpecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephOsdSpecResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OSD specification: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdSpecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephOsdSpecResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spec, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.ApplyOsdSpec(spec)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to apply OSD specification: %s", err))
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read applied OSD specification: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephOsdSpecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephOsdSpecResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteOsdSpec(data.ServiceID.ValueString())
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OSD specification: %s", err))
+		return
+	}
+}
+
+func (r *CephOsdSpecResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("service_id"), req, resp)
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -30,6 +32,7 @@ type CephPoolResourceModel struct {
 	PgAutoscaleMode     types.Bool   `tfsdk:"pg_autoscale_mode"`
 	Size                types.Int64  `tfsdk:"size"`
 	RuleName            types.String `tfsdk:"rule_name"`
+	ErasureCodeProfile  types.String `tfsdk:"erasure_code_profile"`
 	QuotaMaxBytes       types.Int64  `tfsdk:"quota_max_bytes"`
 	ApplicationMetadata types.List   `tfsdk:"application_metadata"`
 	RbdMirroring        types.Bool   `tfsdk:"rbd_mirroring"`
@@ -46,6 +49,7 @@ func (r *CephPoolResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *CephPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
 				Required:    true,
@@ -81,6 +85,14 @@ func (r *CephPoolResource) Schema(ctx context.Context, req resource.SchemaReques
 				Default:     stringdefault.StaticString("replicated_rule"),
 				Description: "The CRUSH rule name. Default: replicated_rule.",
 			},
+			"erasure_code_profile": schema.StringAttribute{
+				Optional: true,
+				Description: "The erasure code profile of an erasure pool. When `rule_name` does not exist, " +
+					"Ceph creates an erasure CRUSH rule with that name from the profile.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"quota_max_bytes": schema.Int64Attribute{
 				Optional:    true,
 				Computed:    true,
@@ -99,6 +111,8 @@ func (r *CephPoolResource) Schema(ctx context.Context, req resource.SchemaReques
 				Computed:    true,
 				Default:     booldefault.StaticBool(false),
 				Description: "Enable RBD mirroring. Default: false.",
+				DeprecationMessage: "This attribute is only sent on creation and does not configure mirroring. " +
+					"Use the ceph_rbd_mirror_pool resource instead.",
 			},
 		},
 	}
@@ -148,6 +162,7 @@ func (r *CephPoolResource) Create(ctx context.Context, req resource.CreateReques
 		PgAutoscaleMode:     pgAutoscaleMode,
 		Size:                int(data.Size.ValueInt64()),
 		RuleName:            data.RuleName.ValueString(),
+		ErasureCodeProfile:  data.ErasureCodeProfile.ValueString(),
 		QuotaMaxBytes:       data.QuotaMaxBytes.ValueInt64(),
 		ApplicationMetadata: appMetadata,
 		RbdMirroring:        data.RbdMirroring.ValueBool(),
@@ -180,6 +195,9 @@ func (r *CephPoolResource) Read(ctx context.Context, req resource.ReadRequest, r
 	data.PgAutoscaleMode = types.BoolValue(pool.PgAutoscaleMode == "on")
 	data.Size = types.Int64Value(int64(pool.Size))
 	data.QuotaMaxBytes = types.Int64Value(pool.QuotaMaxBytes)
+	if !data.ErasureCodeProfile.IsNull() && pool.ErasureCodeProfile != "" {
+		data.ErasureCodeProfile = types.StringValue(pool.ErasureCodeProfile)
+	}
 
 	// Note: RuleName and RbdMirroring might not be returned in the simple GET response or might be named differently.
 	// We'll map what we can.
@@ -0,0 +1,76 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithUpgradeState = &CephPoolResource{}
+
+// CephPoolResourceModelV0 describes the ceph_pool state written before
+// schema versioning was introduced.
+type CephPoolResourceModelV0 struct {
+	Name                types.String `tfsdk:"name"`
+	PgNum               types.Int64  `tfsdk:"pg_num"`
+	Type                types.String `tfsdk:"type"`
+	PgAutoscaleMode     types.Bool   `tfsdk:"pg_autoscale_mode"`
+	Size                types.Int64  `tfsdk:"size"`
+	RuleName            types.String `tfsdk:"rule_name"`
+	QuotaMaxBytes       types.Int64  `tfsdk:"quota_max_bytes"`
+	ApplicationMetadata types.List   `tfsdk:"application_metadata"`
+	RbdMirroring        types.Bool   `tfsdk:"rbd_mirroring"`
+}
+
+// poolSchemaV0 is a frozen copy of the version 0 ceph_pool schema. It must
+// not be changed, as it is used to decode existing state files.
+func poolSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name":              schema.StringAttribute{Required: true},
+			"pg_num":            schema.Int64Attribute{Optional: true, Computed: true},
+			"type":              schema.StringAttribute{Optional: true, Computed: true},
+			"pg_autoscale_mode": schema.BoolAttribute{Optional: true, Computed: true},
+			"size":              schema.Int64Attribute{Optional: true, Computed: true},
+			"rule_name":         schema.StringAttribute{Optional: true, Computed: true},
+			"quota_max_bytes":   schema.Int64Attribute{Optional: true, Computed: true},
+			"application_metadata": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+			},
+			"rbd_mirroring": schema.BoolAttribute{Optional: true, Computed: true},
+		},
+	}
+}
+
+func (r *CephPoolResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: poolSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior CephPoolResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := CephPoolResourceModel{
+					Name:                prior.Name,
+					PgNum:               prior.PgNum,
+					Type:                prior.Type,
+					PgAutoscaleMode:     prior.PgAutoscaleMode,
+					Size:                prior.Size,
+					RuleName:            prior.RuleName,
+					QuotaMaxBytes:       prior.QuotaMaxBytes,
+					ApplicationMetadata: prior.ApplicationMetadata,
+					RbdMirroring:        prior.RbdMirroring,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
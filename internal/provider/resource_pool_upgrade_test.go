@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCephPoolResourceUpgradeStateV0(t *testing.T) {
+	state := testUpgradeState(t, &CephPoolResource{}, 0, "pool_v0.json")
+
+	var data CephPoolResourceModel
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("unable to read upgraded state: %v", diags)
+	}
+
+	if got := data.Name.ValueString(); got != "kubernetes-rbd" {
+		t.Errorf("name = %q, want %q", got, "kubernetes-rbd")
+	}
+	if got := data.PgNum.ValueInt64(); got != 64 {
+		t.Errorf("pg_num = %d, want 64", got)
+	}
+	if got := data.QuotaMaxBytes.ValueInt64(); got != 10737418240 {
+		t.Errorf("quota_max_bytes = %d, want 10737418240", got)
+	}
+	if got := len(data.ApplicationMetadata.Elements()); got != 1 {
+		t.Errorf("application_metadata has %d elements, want 1", got)
+	}
+	if !data.PgAutoscaleMode.ValueBool() {
+		t.Errorf("pg_autoscale_mode = false, want true")
+	}
+}
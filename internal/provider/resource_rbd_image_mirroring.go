@@ -0,0 +1,229 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRbdImageMirroringResource{}
+var _ resource.ResourceWithConfigure = &CephRbdImageMirroringResource{}
+var _ resource.ResourceWithImportState = &CephRbdImageMirroringResource{}
+var _ resource.ResourceWithValidateConfig = &CephRbdImageMirroringResource{}
+
+type CephRbdImageMirroringResource struct {
+	client *client.Client
+}
+
+type CephRbdImageMirroringResourceModel struct {
+	Pool             types.String `tfsdk:"pool"`
+	Image            types.String `tfsdk:"image"`
+	Mode             types.String `tfsdk:"mode"`
+	ScheduleInterval types.String `tfsdk:"schedule_interval"`
+	Primary          types.Bool   `tfsdk:"primary"`
+}
+
+func NewCephRbdImageMirroringResource() resource.Resource {
+	return &CephRbdImageMirroringResource{}
+}
+
+func (r *CephRbdImageMirroringResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbd_image_mirroring"
+}
+
+func (r *CephRbdImageMirroringResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the mirroring of an RBD image in a pool with the image mirroring mode. Destroying the resource disables mirroring of the image.",
+		Attributes: map[string]schema.Attribute{
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "The name of the pool",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"image": schema.StringAttribute{
+				MarkdownDescription: "The name of the image",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "The image mirroring mode: snapshot or journal. Default: snapshot.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(client.RbdImageMirrorModeSnapshot),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"schedule_interval": schema.StringAttribute{
+				MarkdownDescription: "The interval of mirror snapshots for snapshot mirroring: a number followed by m, h or d (e.g., 1h)",
+				Optional:            true,
+			},
+			"primary": schema.BoolAttribute{
+				MarkdownDescription: "Whether the local image is the primary image",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *CephRbdImageMirroringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephRbdImageMirroringResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephRbdImageMirroringResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Mode.IsNull() || data.Mode.IsUnknown() {
+		return
+	}
+	mode := data.Mode.ValueString()
+	if mode != client.RbdImageMirrorModeSnapshot && mode != client.RbdImageMirrorModeJournal {
+		resp.Diagnostics.AddAttributeError(path.Root("mode"), "Invalid Mirroring Mode", fmt.Sprintf("Expected snapshot or journal, got: %s", mode))
+	}
+	if mode == client.RbdImageMirrorModeJournal && !data.ScheduleInterval.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("schedule_interval"), "Invalid Schedule", "Mirror snapshot schedules only apply to snapshot mirroring.")
+	}
+}
+
+func (r *CephRbdImageMirroringResource) refresh(data *CephRbdImageMirroringResourceModel) error {
+	mirroring, err := r.client.GetRbdImageMirroring(data.Pool.ValueString(), data.Image.ValueString())
+	if err != nil {
+		return err
+	}
+	if mirroring.MirrorMode != client.RbdImageMirrorModeSnapshot && mirroring.MirrorMode != client.RbdImageMirrorModeJournal {
+		return fmt.Errorf("mirroring of image %s %w", data.Image.ValueString(), client.ErrNotFound)
+	}
+
+	data.Mode = types.StringValue(mirroring.MirrorMode)
+	data.Primary = types.BoolValue(mirroring.Primary)
+	data.ScheduleInterval = types.StringNull()
+	if mirroring.ScheduleInterval != "" {
+		data.ScheduleInterval = types.StringValue(mirroring.ScheduleInterval)
+	}
+
+	return nil
+}
+
+func (r *CephRbdImageMirroringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRbdImageMirroringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.EnableRbdImageMirroring(data.Pool.ValueString(), data.Image.ValueString(), data.Mode.ValueString(), data.ScheduleInterval.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable image mirroring: %s", err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read image mirroring: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdImageMirroringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRbdImageMirroringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(&data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read image mirroring: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdImageMirroringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephRbdImageMirroringResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the snapshot schedule can change in place
+	err := r.client.EnableRbdImageMirroring(data.Pool.ValueString(), data.Image.ValueString(), data.Mode.ValueString(), data.ScheduleInterval.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update image mirroring: %s", err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read image mirroring: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdImageMirroringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephRbdImageMirroringResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DisableRbdImageMirroring(data.Pool.ValueString(), data.Image.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable image mirroring: %s", err))
+		return
+	}
+}
+
+func (r *CephRbdImageMirroringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pool, image, ok := strings.Cut(req.ID, "/")
+	if !ok || pool == "" || image == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <pool>/<image>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pool"), pool)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("image"), image)...)
+}
@@ -0,0 +1,240 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRbdMirrorPeerResource{}
+var _ resource.ResourceWithConfigure = &CephRbdMirrorPeerResource{}
+var _ resource.ResourceWithImportState = &CephRbdMirrorPeerResource{}
+var _ resource.ResourceWithValidateConfig = &CephRbdMirrorPeerResource{}
+
+type CephRbdMirrorPeerResource struct {
+	client *client.Client
+}
+
+type CephRbdMirrorPeerResourceModel struct {
+	Pool      types.String `tfsdk:"pool"`
+	Token     types.String `tfsdk:"token"`
+	Direction types.String `tfsdk:"direction"`
+	UUID      types.String `tfsdk:"uuid"`
+	SiteName  types.String `tfsdk:"site_name"`
+}
+
+func NewCephRbdMirrorPeerResource() resource.Resource {
+	return &CephRbdMirrorPeerResource{}
+}
+
+func (r *CephRbdMirrorPeerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbd_mirror_peer"
+}
+
+func (r *CephRbdMirrorPeerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Peers a pool with a remote cluster by importing the bootstrap token of the remote pool",
+		Attributes: map[string]schema.Attribute{
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "The name of the local pool",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The bootstrap token of the remote pool, as exported by `ceph_rbd_mirror_bootstrap_token.token`",
+				Required:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					// Imported peers have no token in state and keep the configured one
+					stringplanmodifier.RequiresReplaceIf(func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+						resp.RequiresReplace = !req.StateValue.IsNull()
+					}, "Requires replacement unless the peer was imported", "Requires replacement unless the peer was imported"),
+				},
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "The mirroring direction: rx-only or rx-tx. Default: rx-tx.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("rx-tx"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the peer",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"site_name": schema.StringAttribute{
+				MarkdownDescription: "The site name of the remote cluster",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephRbdMirrorPeerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephRbdMirrorPeerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephRbdMirrorPeerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Direction.IsNull() || data.Direction.IsUnknown() {
+		return
+	}
+	direction := data.Direction.ValueString()
+	if direction != "rx-only" && direction != "rx-tx" {
+		resp.Diagnostics.AddAttributeError(path.Root("direction"), "Invalid Direction", fmt.Sprintf("Expected rx-only or rx-tx, got: %s", direction))
+	}
+}
+
+func (r *CephRbdMirrorPeerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRbdMirrorPeerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pool := data.Pool.ValueString()
+
+	// Remember the existing peers to identify the imported one
+	before, err := r.client.ListRbdMirrorPeers(pool)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list mirroring peers: %s", err))
+		return
+	}
+
+	err = r.client.ImportRbdMirrorBootstrapToken(pool, data.Direction.ValueString(), data.Token.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import bootstrap token: %s", err))
+		return
+	}
+
+	after, err := r.client.ListRbdMirrorPeers(pool)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list mirroring peers: %s", err))
+		return
+	}
+
+	for _, uuid := range after {
+		if !slices.Contains(before, uuid) {
+			data.UUID = types.StringValue(uuid)
+			break
+		}
+	}
+	if data.UUID.IsUnknown() {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the imported peer of pool %s", pool))
+		return
+	}
+
+	peer, err := r.client.GetRbdMirrorPeer(pool, data.UUID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read imported peer: %s", err))
+		return
+	}
+	data.SiteName = types.StringValue(peer.SiteName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorPeerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRbdMirrorPeerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	peer, err := r.client.GetRbdMirrorPeer(data.Pool.ValueString(), data.UUID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mirroring peer: %s", err))
+		return
+	}
+
+	data.SiteName = types.StringValue(peer.SiteName)
+	if peer.Direction != "" {
+		data.Direction = types.StringValue(peer.Direction)
+	} else if data.Direction.IsNull() {
+		data.Direction = types.StringValue("rx-tx")
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorPeerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Only the token of an imported peer can change in place, which only updates state
+	var data CephRbdMirrorPeerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorPeerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephRbdMirrorPeerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRbdMirrorPeer(data.Pool.ValueString(), data.UUID.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete mirroring peer: %s", err))
+		return
+	}
+}
+
+func (r *CephRbdMirrorPeerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	pool, uuid, ok := strings.Cut(req.ID, ":")
+	if !ok || pool == "" || uuid == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <pool>:<uuid>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("pool"), pool)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uuid"), uuid)...)
+}
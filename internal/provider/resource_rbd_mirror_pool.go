@@ -0,0 +1,164 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRbdMirrorPoolResource{}
+var _ resource.ResourceWithConfigure = &CephRbdMirrorPoolResource{}
+var _ resource.ResourceWithImportState = &CephRbdMirrorPoolResource{}
+var _ resource.ResourceWithValidateConfig = &CephRbdMirrorPoolResource{}
+
+type CephRbdMirrorPoolResource struct {
+	client *client.Client
+}
+
+type CephRbdMirrorPoolResourceModel struct {
+	Pool types.String `tfsdk:"pool"`
+	Mode types.String `tfsdk:"mode"`
+}
+
+func NewCephRbdMirrorPoolResource() resource.Resource {
+	return &CephRbdMirrorPoolResource{}
+}
+
+func (r *CephRbdMirrorPoolResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbd_mirror_pool"
+}
+
+func (r *CephRbdMirrorPoolResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the RBD mirroring mode of a pool. Destroying the resource disables mirroring.",
+		Attributes: map[string]schema.Attribute{
+			"pool": schema.StringAttribute{
+				MarkdownDescription: "The name of the pool",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "The mirroring mode: image (images are mirrored when enabled explicitly) or pool (all journaling images are mirrored)",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *CephRbdMirrorPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephRbdMirrorPoolResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephRbdMirrorPoolResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Mode.IsUnknown() {
+		return
+	}
+	mode := data.Mode.ValueString()
+	if mode != client.RbdMirrorModeImage && mode != client.RbdMirrorModePool {
+		resp.Diagnostics.AddAttributeError(path.Root("mode"), "Invalid Mirroring Mode", fmt.Sprintf("Expected image or pool, got: %s", mode))
+	}
+}
+
+func (r *CephRbdMirrorPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRbdMirrorPoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetRbdMirrorPoolMode(data.Pool.ValueString(), data.Mode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to enable pool mirroring: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorPoolResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRbdMirrorPoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mode, err := r.client.GetRbdMirrorPoolMode(data.Pool.ValueString())
+	if errors.Is(err, client.ErrNotFound) || (err == nil && mode == client.RbdMirrorModeDisabled) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read pool mirroring: %s", err))
+		return
+	}
+
+	data.Mode = types.StringValue(mode)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephRbdMirrorPoolResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetRbdMirrorPoolMode(data.Pool.ValueString(), data.Mode.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update pool mirroring: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorPoolResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephRbdMirrorPoolResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetRbdMirrorPoolMode(data.Pool.ValueString(), client.RbdMirrorModeDisabled)
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable pool mirroring: %s", err))
+		return
+	}
+}
+
+func (r *CephRbdMirrorPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("pool"), req, resp)
+}
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRbdMirrorSiteNameResource{}
+var _ resource.ResourceWithConfigure = &CephRbdMirrorSiteNameResource{}
+var _ resource.ResourceWithImportState = &CephRbdMirrorSiteNameResource{}
+
+type CephRbdMirrorSiteNameResource struct {
+	client *client.Client
+}
+
+type CephRbdMirrorSiteNameResourceModel struct {
+	SiteName types.String `tfsdk:"site_name"`
+}
+
+func NewCephRbdMirrorSiteNameResource() resource.Resource {
+	return &CephRbdMirrorSiteNameResource{}
+}
+
+func (r *CephRbdMirrorSiteNameResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rbd_mirror_site_name"
+}
+
+func (r *CephRbdMirrorSiteNameResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the RBD mirroring site name of the cluster, as shown to its peers. " +
+			"Only one instance should exist per cluster; destroying it leaves the site name unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"site_name": schema.StringAttribute{
+				MarkdownDescription: "The site name (e.g., site-a)",
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *CephRbdMirrorSiteNameResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephRbdMirrorSiteNameResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRbdMirrorSiteNameResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetRbdMirrorSiteName(data.SiteName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set mirroring site name: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorSiteNameResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRbdMirrorSiteNameResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	siteName, err := r.client.GetRbdMirrorSiteName()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mirroring site name: %s", err))
+		return
+	}
+
+	data.SiteName = types.StringValue(siteName)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorSiteNameResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephRbdMirrorSiteNameResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.SetRbdMirrorSiteName(data.SiteName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set mirroring site name: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRbdMirrorSiteNameResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// The site name cannot be unset; the resource is only removed from state.
+}
+
+func (r *CephRbdMirrorSiteNameResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("site_name"), req, resp)
+}
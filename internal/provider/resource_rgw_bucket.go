@@ -0,0 +1,496 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRGWBucketResource{}
+var _ resource.ResourceWithConfigure = &CephRGWBucketResource{}
+var _ resource.ResourceWithImportState = &CephRGWBucketResource{}
+var _ resource.ResourceWithValidateConfig = &CephRGWBucketResource{}
+
+type CephRGWBucketResource struct {
+	client *client.Client
+}
+
+type CephRGWBucketResourceModel struct {
+	Name                     types.String `tfsdk:"name"`
+	Owner                    types.String `tfsdk:"owner"`
+	PlacementTarget          types.String `tfsdk:"placement_target"`
+	Versioning               types.Bool   `tfsdk:"versioning"`
+	MFADelete                types.Bool   `tfsdk:"mfa_delete"`
+	MFATokenSerial           types.String `tfsdk:"mfa_token_serial"`
+	MFATokenPin              types.String `tfsdk:"mfa_token_pin"`
+	ObjectLockEnabled        types.Bool   `tfsdk:"object_lock_enabled"`
+	ObjectLockMode           types.String `tfsdk:"object_lock_mode"`
+	ObjectLockRetentionDays  types.Int64  `tfsdk:"object_lock_retention_days"`
+	ObjectLockRetentionYears types.Int64  `tfsdk:"object_lock_retention_years"`
+	Encryption               types.String `tfsdk:"encryption"`
+	KMSKeyID                 types.String `tfsdk:"kms_key_id"`
+	LifecyclePolicy          types.String `tfsdk:"lifecycle_policy"`
+	Policy                   types.String `tfsdk:"policy"`
+	Tags                     types.Map    `tfsdk:"tags"`
+	ForceDestroy             types.Bool   `tfsdk:"force_destroy"`
+	BucketID                 types.String `tfsdk:"bucket_id"`
+}
+
+func NewCephRGWBucketResource() resource.Resource {
+	return &CephRGWBucketResource{}
+}
+
+func (r *CephRGWBucketResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rgw_bucket"
+}
+
+func (r *CephRGWBucketResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an RGW (object storage) bucket",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the bucket",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"owner": schema.StringAttribute{
+				MarkdownDescription: "The full ID of the owning RGW user, as exported by `ceph_rgw_user.id`. Changing the owner relinks the bucket.",
+				Required:            true,
+			},
+			"placement_target": schema.StringAttribute{
+				MarkdownDescription: "The placement target of the bucket (e.g., default-placement). Defaults to the zonegroup's default placement.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"versioning": schema.BoolAttribute{
+				MarkdownDescription: "Whether object versioning is enabled. Disabling suspends versioning. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"mfa_delete": schema.BoolAttribute{
+				MarkdownDescription: "Whether deleting object versions requires MFA. Requires `versioning`, `mfa_token_serial` and `mfa_token_pin`. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"mfa_token_serial": schema.StringAttribute{
+				MarkdownDescription: "The serial of the MFA token used to change `mfa_delete`",
+				Optional:            true,
+			},
+			"mfa_token_pin": schema.StringAttribute{
+				MarkdownDescription: "The current PIN of the MFA token used to change `mfa_delete`",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"object_lock_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether object lock is enabled. Requires `versioning`. Can only be set at creation. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"object_lock_mode": schema.StringAttribute{
+				MarkdownDescription: "The default object lock retention mode: GOVERNANCE or COMPLIANCE",
+				Optional:            true,
+			},
+			"object_lock_retention_days": schema.Int64Attribute{
+				MarkdownDescription: "The default object lock retention period in days. Conflicts with `object_lock_retention_years`.",
+				Optional:            true,
+			},
+			"object_lock_retention_years": schema.Int64Attribute{
+				MarkdownDescription: "The default object lock retention period in years. Conflicts with `object_lock_retention_days`.",
+				Optional:            true,
+			},
+			"encryption": schema.StringAttribute{
+				MarkdownDescription: "The default server-side encryption algorithm: AES256 (SSE-S3) or aws:kms (SSE-KMS). Unset disables default encryption.",
+				Optional:            true,
+			},
+			"kms_key_id": schema.StringAttribute{
+				MarkdownDescription: "The KMS key ID used with aws:kms encryption",
+				Optional:            true,
+			},
+			"lifecycle_policy": schema.StringAttribute{
+				MarkdownDescription: "The lifecycle configuration in the S3 XML or JSON format. " +
+					"It is compared with the configuration of the bucket regardless of its format, so changes made outside of Terraform are detected.",
+				Optional: true,
+			},
+			"policy": schema.StringAttribute{
+				MarkdownDescription: "The bucket policy as a JSON document",
+				Optional:            true,
+			},
+			"tags": schema.MapAttribute{
+				MarkdownDescription: "The tags of the bucket",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Whether destroying the bucket purges its objects. Otherwise deleting a non-empty bucket fails. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"bucket_id": schema.StringAttribute{
+				MarkdownDescription: "The internal ID of the bucket",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephRGWBucketResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephRGWBucketResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephRGWBucketResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versioning := data.Versioning.ValueBool()
+	if data.MFADelete.ValueBool() && !data.Versioning.IsUnknown() && !versioning {
+		resp.Diagnostics.AddAttributeError(path.Root("mfa_delete"), "Invalid MFA Delete", "mfa_delete requires versioning to be enabled.")
+	}
+	if data.ObjectLockEnabled.ValueBool() && !data.Versioning.IsUnknown() && !versioning {
+		resp.Diagnostics.AddAttributeError(path.Root("object_lock_enabled"), "Invalid Object Lock", "Object lock requires versioning to be enabled.")
+	}
+
+	lockConfigured := !data.ObjectLockMode.IsNull() || !data.ObjectLockRetentionDays.IsNull() || !data.ObjectLockRetentionYears.IsNull()
+	if lockConfigured && !data.ObjectLockEnabled.IsUnknown() && !data.ObjectLockEnabled.ValueBool() {
+		resp.Diagnostics.AddAttributeError(path.Root("object_lock_mode"), "Invalid Object Lock", "The default retention requires object_lock_enabled.")
+	}
+	if !data.ObjectLockMode.IsNull() && !data.ObjectLockMode.IsUnknown() {
+		mode := data.ObjectLockMode.ValueString()
+		if mode != "GOVERNANCE" && mode != "COMPLIANCE" {
+			resp.Diagnostics.AddAttributeError(path.Root("object_lock_mode"), "Invalid Object Lock Mode", fmt.Sprintf("Expected GOVERNANCE or COMPLIANCE, got: %s", mode))
+		}
+		if data.ObjectLockRetentionDays.IsNull() == data.ObjectLockRetentionYears.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("object_lock_mode"), "Invalid Object Lock", "Exactly one of object_lock_retention_days and object_lock_retention_years must be set with object_lock_mode.")
+		}
+	}
+
+	if !data.Encryption.IsNull() && !data.Encryption.IsUnknown() {
+		encryption := data.Encryption.ValueString()
+		if encryption != "AES256" && encryption != "aws:kms" {
+			resp.Diagnostics.AddAttributeError(path.Root("encryption"), "Invalid Encryption", fmt.Sprintf("Expected AES256 or aws:kms, got: %s", encryption))
+		}
+		if encryption == "aws:kms" && data.KMSKeyID.IsNull() {
+			resp.Diagnostics.AddAttributeError(path.Root("kms_key_id"), "Missing KMS Key ID", "kms_key_id is required with aws:kms encryption.")
+		}
+	}
+
+	if !data.Policy.IsNull() && !data.Policy.IsUnknown() && !json.Valid([]byte(data.Policy.ValueString())) {
+		resp.Diagnostics.AddAttributeError(path.Root("policy"), "Invalid Policy", "The bucket policy must be a valid JSON document.")
+	}
+	if !data.LifecyclePolicy.IsNull() && !data.LifecyclePolicy.IsUnknown() {
+		if _, err := client.NormalizeRGWLifecycle(data.LifecyclePolicy.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("lifecycle_policy"), "Invalid Lifecycle Policy", err.Error())
+		}
+	}
+}
+
+// lifecycleEquivalent reports whether two lifecycle configurations are equal once normalized
+func lifecycleEquivalent(a, b string) bool {
+	na, err := client.NormalizeRGWLifecycle(a)
+	if err != nil {
+		return false
+	}
+	nb, err := client.NormalizeRGWLifecycle(b)
+	if err != nil {
+		return false
+	}
+	return na == nb
+}
+
+// jsonEquivalent reports whether two strings hold semantically equal JSON documents
+func jsonEquivalent(a, b string) bool {
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// expandRGWBucketRequest builds the bucket request from the model. A versioning
+// state is only sent to enable versioning, or to suspend it on a versioned bucket,
+// so that versioning stays unset on buckets that were never versioned.
+func expandRGWBucketRequest(ctx context.Context, data *CephRGWBucketResourceModel, versioned bool) (client.RGWBucketRequest, error) {
+	bucket := client.RGWBucketRequest{
+		Bucket:                   data.Name.ValueString(),
+		BucketID:                 data.BucketID.ValueString(),
+		UID:                      data.Owner.ValueString(),
+		MFATokenSerial:           data.MFATokenSerial.ValueString(),
+		MFATokenPin:              data.MFATokenPin.ValueString(),
+		LockEnabled:              data.ObjectLockEnabled.ValueBool(),
+		LockMode:                 data.ObjectLockMode.ValueString(),
+		LockRetentionPeriodDays:  data.ObjectLockRetentionDays.ValueInt64(),
+		LockRetentionPeriodYears: data.ObjectLockRetentionYears.ValueInt64(),
+		EncryptionState:          !data.Encryption.IsNull(),
+		EncryptionType:           data.Encryption.ValueString(),
+		KeyID:                    data.KMSKeyID.ValueString(),
+		BucketPolicy:             data.Policy.ValueString(),
+		Tags:                     map[string]string{},
+	}
+	if !data.PlacementTarget.IsUnknown() {
+		bucket.PlacementTarget = data.PlacementTarget.ValueString()
+	}
+	if data.Versioning.ValueBool() {
+		bucket.VersioningState = client.RGWStateEnabled
+	} else if versioned {
+		bucket.VersioningState = client.RGWStateSuspended
+	}
+	if bucket.VersioningState != "" {
+		bucket.MFADelete = client.RGWStateDisabled
+		if data.MFADelete.ValueBool() {
+			bucket.MFADelete = client.RGWStateEnabled
+		}
+	}
+	if !data.Tags.IsNull() {
+		diags := data.Tags.ElementsAs(ctx, &bucket.Tags, false)
+		if diags.HasError() {
+			return bucket, fmt.Errorf("unable to read tags: %v", diags)
+		}
+	}
+
+	return bucket, nil
+}
+
+func (r *CephRGWBucketResource) refresh(ctx context.Context, data *CephRGWBucketResourceModel) error {
+	name := data.Name.ValueString()
+	bucket, err := r.client.GetRGWBucket(name)
+	if err != nil {
+		return err
+	}
+
+	data.BucketID = types.StringValue(bucket.ID)
+	data.Owner = types.StringValue(bucket.Owner)
+	data.PlacementTarget = types.StringValue(bucket.PlacementRule)
+	data.Versioning = types.BoolValue(bucket.Versioning == client.RGWStateEnabled)
+	data.MFADelete = types.BoolValue(bucket.MFADelete == client.RGWStateEnabled)
+	data.ObjectLockEnabled = types.BoolValue(bool(bucket.LockEnabled))
+
+	data.ObjectLockMode = types.StringNull()
+	data.ObjectLockRetentionDays = types.Int64Null()
+	data.ObjectLockRetentionYears = types.Int64Null()
+	if bucket.LockEnabled && bucket.LockMode != "" {
+		data.ObjectLockMode = types.StringValue(bucket.LockMode)
+		if bucket.LockRetentionPeriodDays > 0 {
+			data.ObjectLockRetentionDays = types.Int64Value(bucket.LockRetentionPeriodDays)
+		}
+		if bucket.LockRetentionPeriodYears > 0 {
+			data.ObjectLockRetentionYears = types.Int64Value(bucket.LockRetentionPeriodYears)
+		}
+	}
+
+	policy := bucket.Policy()
+	if policy == "" {
+		data.Policy = types.StringNull()
+	} else if !jsonEquivalent(data.Policy.ValueString(), policy) {
+		data.Policy = types.StringValue(policy)
+	}
+
+	if len(bucket.Tagset) == 0 {
+		data.Tags = types.MapNull(types.StringType)
+	} else {
+		tags, diags := types.MapValueFrom(ctx, types.StringType, bucket.Tagset)
+		if diags.HasError() {
+			return fmt.Errorf("unable to set tags: %v", diags)
+		}
+		data.Tags = tags
+	}
+
+	algorithm, keyID, err := r.client.GetRGWBucketEncryption(name)
+	if err != nil {
+		return err
+	}
+	data.Encryption = types.StringNull()
+	data.KMSKeyID = types.StringNull()
+	if algorithm != "" {
+		data.Encryption = types.StringValue(algorithm)
+	}
+	if keyID != "" {
+		data.KMSKeyID = types.StringValue(keyID)
+	}
+
+	// The API returns the lifecycle in its own format, so the configured one is
+	// kept as long as both are equivalent
+	lifecycle, err := r.client.GetRGWBucketLifecycle(name)
+	if err != nil {
+		return err
+	}
+	if lifecycle == "" {
+		data.LifecyclePolicy = types.StringNull()
+	} else if !lifecycleEquivalent(data.LifecyclePolicy.ValueString(), lifecycle) {
+		data.LifecyclePolicy = types.StringValue(lifecycle)
+	}
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+
+	return nil
+}
+
+func (r *CephRGWBucketResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRGWBucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := expandRGWBucketRequest(ctx, &data, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	err = r.client.CreateRGWBucket(bucket)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RGW bucket: %s", err))
+		return
+	}
+
+	// Versioning and MFA delete can only be set on an existing bucket
+	if data.Versioning.ValueBool() || data.MFADelete.ValueBool() {
+		created, err := r.client.GetRGWBucket(bucket.Bucket)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created RGW bucket: %s", err))
+			return
+		}
+		bucket.BucketID = created.ID
+
+		err = r.client.UpdateRGWBucket(bucket.Bucket, bucket)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to configure versioning of RGW bucket: %s", err))
+			return
+		}
+	}
+
+	if !data.LifecyclePolicy.IsNull() {
+		err = r.client.SetRGWBucketLifecycle(bucket.Bucket, data.LifecyclePolicy.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set lifecycle of RGW bucket: %s", err))
+			return
+		}
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created RGW bucket: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWBucketResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRGWBucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RGW bucket: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWBucketResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state CephRGWBucketResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, err := expandRGWBucketRequest(ctx, &plan, state.Versioning.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+
+	err = r.client.UpdateRGWBucket(plan.Name.ValueString(), bucket)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RGW bucket: %s", err))
+		return
+	}
+
+	if !plan.LifecyclePolicy.Equal(state.LifecyclePolicy) {
+		err = r.client.SetRGWBucketLifecycle(plan.Name.ValueString(), plan.LifecyclePolicy.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set lifecycle of RGW bucket: %s", err))
+			return
+		}
+	}
+
+	err = r.refresh(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated RGW bucket: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *CephRGWBucketResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephRGWBucketResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRGWBucket(data.Name.ValueString(), data.ForceDestroy.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RGW bucket: %s", err))
+		return
+	}
+}
+
+func (r *CephRGWBucketResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+}
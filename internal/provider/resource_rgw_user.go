@@ -0,0 +1,324 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRGWUserResource{}
+var _ resource.ResourceWithConfigure = &CephRGWUserResource{}
+var _ resource.ResourceWithImportState = &CephRGWUserResource{}
+
+type CephRGWUserResource struct {
+	client *client.Client
+}
+
+type CephRGWUserResourceModel struct {
+	UID         types.String       `tfsdk:"uid"`
+	Tenant      types.String       `tfsdk:"tenant"`
+	DisplayName types.String       `tfsdk:"display_name"`
+	Email       types.String       `tfsdk:"email"`
+	MaxBuckets  types.Int64        `tfsdk:"max_buckets"`
+	Suspended   types.Bool         `tfsdk:"suspended"`
+	System      types.Bool         `tfsdk:"system"`
+	UserQuota   *CephRGWQuotaModel `tfsdk:"user_quota"`
+	BucketQuota *CephRGWQuotaModel `tfsdk:"bucket_quota"`
+	ID          types.String       `tfsdk:"id"`
+}
+
+// CephRGWQuotaModel describes an RGW quota. A null limit means unlimited.
+type CephRGWQuotaModel struct {
+	MaxSizeKB  types.Int64 `tfsdk:"max_size_kb"`
+	MaxObjects types.Int64 `tfsdk:"max_objects"`
+}
+
+func NewCephRGWUserResource() resource.Resource {
+	return &CephRGWUserResource{}
+}
+
+func (r *CephRGWUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rgw_user"
+}
+
+// rgwQuotaAttribute returns the schema of an RGW quota block
+func rgwQuotaAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		Attributes: map[string]schema.Attribute{
+			"max_size_kb": schema.Int64Attribute{
+				MarkdownDescription: "The maximum size in KiB. Unset means unlimited.",
+				Optional:            true,
+			},
+			"max_objects": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of objects. Unset means unlimited.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+// expandRGWQuota converts a quota block into a client quota, where no block disables the quota
+func expandRGWQuota(quota *CephRGWQuotaModel) client.RGWQuota {
+	if quota == nil {
+		return client.RGWQuota{MaxSizeKB: -1, MaxObjects: -1}
+	}
+
+	result := client.RGWQuota{Enabled: true, MaxSizeKB: -1, MaxObjects: -1}
+	if !quota.MaxSizeKB.IsNull() {
+		result.MaxSizeKB = quota.MaxSizeKB.ValueInt64()
+	}
+	if !quota.MaxObjects.IsNull() {
+		result.MaxObjects = quota.MaxObjects.ValueInt64()
+	}
+	return result
+}
+
+// flattenRGWQuota converts a client quota into a quota block, where a disabled quota is null
+func flattenRGWQuota(quota client.RGWQuota) *CephRGWQuotaModel {
+	if !quota.Enabled {
+		return nil
+	}
+
+	result := &CephRGWQuotaModel{
+		MaxSizeKB:  types.Int64Null(),
+		MaxObjects: types.Int64Null(),
+	}
+	if quota.MaxSizeKB > 0 {
+		result.MaxSizeKB = types.Int64Value(quota.MaxSizeKB)
+	}
+	if quota.MaxObjects >= 0 {
+		result.MaxObjects = types.Int64Value(quota.MaxObjects)
+	}
+	return result
+}
+
+func (r *CephRGWUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an RGW (object storage) user. S3 keys are managed with `ceph_rgw_user_key`.",
+		Attributes: map[string]schema.Attribute{
+			"uid": schema.StringAttribute{
+				MarkdownDescription: "The user ID (e.g., alice)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tenant": schema.StringAttribute{
+				MarkdownDescription: "The tenant of the user. Unset means the default (global) tenant.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "The display name of the user",
+				Required:            true,
+			},
+			"email": schema.StringAttribute{
+				MarkdownDescription: "The email address of the user",
+				Optional:            true,
+			},
+			"max_buckets": schema.Int64Attribute{
+				MarkdownDescription: "The maximum number of buckets the user can own. Default: 1000.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1000),
+			},
+			"suspended": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is suspended. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"system": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a system user, as used for multisite synchronization. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"user_quota":   rgwQuotaAttribute("The quota applied to all buckets of the user combined. Unset disables the quota."),
+			"bucket_quota": rgwQuotaAttribute("The quota applied to each bucket of the user. Unset disables the quota."),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The full user ID, prefixed by the tenant if any (e.g., acme$alice)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephRGWUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func expandRGWUserRequest(data *CephRGWUserResourceModel) client.RGWUserRequest {
+	return client.RGWUserRequest{
+		UID:         data.ID.ValueString(),
+		DisplayName: data.DisplayName.ValueString(),
+		Email:       data.Email.ValueString(),
+		MaxBuckets:  data.MaxBuckets.ValueInt64(),
+		Suspended:   data.Suspended.ValueBool(),
+		System:      data.System.ValueBool(),
+	}
+}
+
+// setQuotas applies both quotas of the user
+func (r *CephRGWUserResource) setQuotas(data *CephRGWUserResourceModel) error {
+	err := r.client.SetRGWUserQuota(data.ID.ValueString(), "user", expandRGWQuota(data.UserQuota))
+	if err != nil {
+		return err
+	}
+	return r.client.SetRGWUserQuota(data.ID.ValueString(), "bucket", expandRGWQuota(data.BucketQuota))
+}
+
+func (r *CephRGWUserResource) refresh(data *CephRGWUserResourceModel) error {
+	user, err := r.client.GetRGWUser(data.ID.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.DisplayName = types.StringValue(user.DisplayName)
+	if user.Email != "" || !data.Email.IsNull() {
+		data.Email = types.StringValue(user.Email)
+	}
+	data.MaxBuckets = types.Int64Value(user.MaxBuckets)
+	data.Suspended = types.BoolValue(bool(user.Suspended))
+	data.System = types.BoolValue(bool(user.System))
+	data.UserQuota = flattenRGWQuota(user.UserQuota)
+	data.BucketQuota = flattenRGWQuota(user.BucketQuota)
+
+	return nil
+}
+
+func (r *CephRGWUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRGWUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(client.RGWUserID(data.Tenant.ValueString(), data.UID.ValueString()))
+
+	err := r.client.CreateRGWUser(expandRGWUserRequest(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RGW user: %s", err))
+		return
+	}
+
+	if data.UserQuota != nil || data.BucketQuota != nil {
+		err = r.setQuotas(&data)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set RGW user quotas: %s", err))
+			return
+		}
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created RGW user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRGWUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(&data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RGW user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephRGWUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateRGWUser(data.ID.ValueString(), expandRGWUserRequest(&data))
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RGW user: %s", err))
+		return
+	}
+
+	err = r.setQuotas(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set RGW user quotas: %s", err))
+		return
+	}
+
+	err = r.refresh(&data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated RGW user: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephRGWUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRGWUser(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RGW user: %s", err))
+		return
+	}
+}
+
+func (r *CephRGWUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	uid := req.ID
+	if tenant, name, ok := strings.Cut(req.ID, "$"); ok {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tenant"), tenant)...)
+		uid = name
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("uid"), uid)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+}
@@ -0,0 +1,236 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRGWUserKeyResource{}
+var _ resource.ResourceWithConfigure = &CephRGWUserKeyResource{}
+var _ resource.ResourceWithImportState = &CephRGWUserKeyResource{}
+var _ resource.ResourceWithValidateConfig = &CephRGWUserKeyResource{}
+
+type CephRGWUserKeyResource struct {
+	client *client.Client
+}
+
+type CephRGWUserKeyResourceModel struct {
+	User      types.String `tfsdk:"user"`
+	AccessKey types.String `tfsdk:"access_key"`
+	SecretKey types.String `tfsdk:"secret_key"`
+}
+
+func NewCephRGWUserKeyResource() resource.Resource {
+	return &CephRGWUserKeyResource{}
+}
+
+func (r *CephRGWUserKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rgw_user_key"
+}
+
+func (r *CephRGWUserKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an S3 access/secret key pair of an RGW user. The key pair is generated unless both keys are set.",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				MarkdownDescription: "The full ID of the RGW user, as exported by `ceph_rgw_user.id`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_key": schema.StringAttribute{
+				MarkdownDescription: "The S3 access key. Generated if unset.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secret_key": schema.StringAttribute{
+				MarkdownDescription: "The S3 secret key. Generated if unset.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephRGWUserKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephRGWUserKeyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CephRGWUserKeyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.AccessKey.IsNull() != data.SecretKey.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Key Pair",
+			"Both access_key and secret_key must be set to import a key pair, or neither to generate one.",
+		)
+	}
+}
+
+// findRGWKey returns the S3 key pair of a user with the given access key
+func findRGWKey(user *client.RGWUser, accessKey string) *client.RGWKey {
+	for _, key := range user.Keys {
+		if key.AccessKey == accessKey {
+			return &key
+		}
+	}
+	return nil
+}
+
+func (r *CephRGWUserKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRGWUserKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uid := data.User.ValueString()
+	generate := data.AccessKey.IsUnknown() && data.SecretKey.IsUnknown()
+
+	// Remember the existing keys to identify the generated one
+	before, err := r.client.GetRGWUser(uid)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RGW user: %s", err))
+		return
+	}
+
+	if generate {
+		err = r.client.CreateRGWUserKey(uid, "", "")
+	} else {
+		err = r.client.CreateRGWUserKey(uid, data.AccessKey.ValueString(), data.SecretKey.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RGW user key: %s", err))
+		return
+	}
+
+	after, err := r.client.GetRGWUser(uid)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RGW user: %s", err))
+		return
+	}
+
+	var key *client.RGWKey
+	if generate {
+		for _, k := range after.Keys {
+			if findRGWKey(before, k.AccessKey) == nil {
+				key = &k
+				break
+			}
+		}
+	} else {
+		key = findRGWKey(after, data.AccessKey.ValueString())
+	}
+	if key == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find the created key of RGW user %s", uid))
+		return
+	}
+
+	data.AccessKey = types.StringValue(key.AccessKey)
+	data.SecretKey = types.StringValue(key.SecretKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWUserKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRGWUserKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.client.GetRGWUser(data.User.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RGW user: %s", err))
+		return
+	}
+
+	key := findRGWKey(user, data.AccessKey.ValueString())
+	if key == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	data.SecretKey = types.StringValue(key.SecretKey)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWUserKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// All attributes require replacement
+	var data CephRGWUserKeyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWUserKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephRGWUserKeyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRGWUserKey(data.User.ValueString(), data.AccessKey.ValueString())
+	if errors.Is(err, client.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RGW user key: %s", err))
+		return
+	}
+}
+
+func (r *CephRGWUserKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	user, accessKey, ok := strings.Cut(req.ID, ":")
+	if !ok || user == "" || accessKey == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <user>:<access_key>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), user)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access_key"), accessKey)...)
+}
@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRGWZoneResource{}
+var _ resource.ResourceWithConfigure = &CephRGWZoneResource{}
+var _ resource.ResourceWithImportState = &CephRGWZoneResource{}
+
+type CephRGWZoneResource struct {
+	client *client.Client
+}
+
+type CephRGWZoneResourceModel struct {
+	Name            types.String                `tfsdk:"name"`
+	Zonegroup       types.String                `tfsdk:"zonegroup"`
+	Realm           types.String                `tfsdk:"realm"`
+	Master          types.Bool                  `tfsdk:"master"`
+	Default         types.Bool                  `tfsdk:"default"`
+	Endpoints       types.List                  `tfsdk:"endpoints"`
+	SystemAccessKey types.String                `tfsdk:"system_access_key"`
+	SystemSecretKey types.String                `tfsdk:"system_secret_key"`
+	PlacementPools  []CephRGWPlacementPoolModel `tfsdk:"placement_pools"`
+	ID              types.String                `tfsdk:"id"`
+}
+
+type CephRGWPlacementPoolModel struct {
+	PlacementTarget types.String `tfsdk:"placement_target"`
+	DataPool        types.String `tfsdk:"data_pool"`
+	IndexPool       types.String `tfsdk:"index_pool"`
+	DataExtraPool   types.String `tfsdk:"data_extra_pool"`
+}
+
+func NewCephRGWZoneResource() resource.Resource {
+	return &CephRGWZoneResource{}
+}
+
+func (r *CephRGWZoneResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rgw_zone"
+}
+
+func (r *CephRGWZoneResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an RGW multisite zone. The period of the realm is committed after every change.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the zone",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zonegroup": schema.StringAttribute{
+				MarkdownDescription: "The name of the zonegroup the zone belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"realm": schema.StringAttribute{
+				MarkdownDescription: "The name of the realm the zonegroup belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"master": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the master zone of the zonegroup. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the default zone. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"endpoints": schema.ListAttribute{
+				MarkdownDescription: "The endpoints of the zone (e.g., http://rgw1.site-a.example.com:8080)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"system_access_key": schema.StringAttribute{
+				MarkdownDescription: "The access key of the system user used for multisite synchronization. Unset keeps the current key.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_secret_key": schema.StringAttribute{
+				MarkdownDescription: "The secret key of the system user used for multisite synchronization. Unset keeps the current key.",
+				Optional:            true,
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"placement_pools": schema.ListNestedAttribute{
+				MarkdownDescription: "The pools used by the zone for each placement target. Unset keeps the pools created by RGW.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"placement_target": schema.StringAttribute{
+							MarkdownDescription: "The name of the placement target (e.g., default-placement)",
+							Required:            true,
+						},
+						"data_pool": schema.StringAttribute{
+							MarkdownDescription: "The pool storing object data of the STANDARD storage class",
+							Required:            true,
+						},
+						"index_pool": schema.StringAttribute{
+							MarkdownDescription: "The pool storing bucket indexes",
+							Required:            true,
+						},
+						"data_extra_pool": schema.StringAttribute{
+							MarkdownDescription: "The pool storing data of incomplete multipart uploads",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the zone",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephRGWZoneResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CephRGWZoneResource) refresh(ctx context.Context, data *CephRGWZoneResourceModel) error {
+	zone, err := r.client.GetRGWZone(data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+
+	zonegroup, err := r.client.GetRGWZonegroup(data.Zonegroup.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var found *client.RGWZonegroupZone
+	for _, z := range zonegroup.Zones {
+		if z.ID == zone.ID {
+			found = &z
+			break
+		}
+	}
+	if found == nil {
+		return fmt.Errorf("zone %s in zonegroup %s %w", zone.Name, zonegroup.Name, client.ErrNotFound)
+	}
+
+	data.ID = types.StringValue(zone.ID)
+	data.Master = types.BoolValue(zonegroup.MasterZone == zone.ID)
+	// The API does not report whether the zone is the default one
+	if data.Default.IsNull() {
+		data.Default = types.BoolValue(false)
+	}
+
+	endpoints, diags := flattenRGWEndpoints(ctx, found.Endpoints)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set endpoints: %v", diags)
+	}
+	data.Endpoints = endpoints
+
+	data.SystemAccessKey = types.StringNull()
+	data.SystemSecretKey = types.StringNull()
+	if zone.SystemKey.AccessKey != "" {
+		data.SystemAccessKey = types.StringValue(zone.SystemKey.AccessKey)
+		data.SystemSecretKey = types.StringValue(zone.SystemKey.SecretKey)
+	}
+
+	// Pools are only tracked when managed, RGW creates default pools for every zone
+	if data.PlacementPools != nil {
+		pools := make([]CephRGWPlacementPoolModel, 0, len(zone.PlacementPools))
+		for _, p := range zone.PlacementPools {
+			pools = append(pools, CephRGWPlacementPoolModel{
+				PlacementTarget: types.StringValue(p.Key),
+				DataPool:        types.StringValue(p.Val.StorageClasses.Standard.DataPool),
+				IndexPool:       types.StringValue(p.Val.IndexPool),
+				DataExtraPool:   types.StringValue(p.Val.DataExtraPool),
+			})
+		}
+		data.PlacementPools = pools
+	}
+
+	return nil
+}
+
+// apply sets the endpoints, system keys and placement pools of the zone
+func (r *CephRGWZoneResource) apply(ctx context.Context, data *CephRGWZoneResourceModel, create bool) error {
+	endpoints, diags := expandRGWEndpoints(ctx, data.Endpoints)
+	if diags.HasError() {
+		return fmt.Errorf("unable to read endpoints: %v", diags)
+	}
+
+	zone := client.RGWZoneRequest{
+		ZoneName:      data.Name.ValueString(),
+		ZonegroupName: data.Zonegroup.ValueString(),
+		Default:       data.Default.ValueBool(),
+		Master:        data.Master.ValueBool(),
+		Endpoints:     endpoints,
+		AccessKey:     data.SystemAccessKey.ValueString(),
+		SecretKey:     data.SystemSecretKey.ValueString(),
+	}
+
+	var err error
+	if create {
+		err = r.client.CreateRGWZone(zone)
+	} else {
+		err = r.client.UpdateRGWZone(zone)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, p := range data.PlacementPools {
+		err = r.client.SetRGWZonePlacement(client.RGWZonePlacementRequest{
+			ZoneName:        zone.ZoneName,
+			ZonegroupName:   zone.ZonegroupName,
+			PlacementTarget: p.PlacementTarget.ValueString(),
+			DataPool:        p.DataPool.ValueString(),
+			IndexPool:       p.IndexPool.ValueString(),
+			DataExtraPool:   p.DataExtraPool.ValueString(),
+			StorageClass:    "STANDARD",
+		})
+		if err != nil {
+			return fmt.Errorf("unable to set pools of placement target %s: %w", p.PlacementTarget.ValueString(), err)
+		}
+	}
+
+	return nil
+}
+
+func (r *CephRGWZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRGWZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, &data, true)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RGW zone: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(commitRGWPeriod(r.client, data.Realm.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created RGW zone: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRGWZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RGW zone: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephRGWZoneResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.apply(ctx, &data, false)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RGW zone: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(commitRGWPeriod(r.client, data.Realm.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated RGW zone: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephRGWZoneResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRGWZone(data.Name.ValueString(), data.Zonegroup.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RGW zone: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(commitRGWPeriod(r.client, data.Realm.ValueString())...)
+}
+
+func (r *CephRGWZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, ":")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <realm>:<zonegroup>:<zone>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("realm"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("zonegroup"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[2])...)
+}
@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/clouddicted/terraform-provider-ceph/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.Resource = &CephRGWZonegroupResource{}
+var _ resource.ResourceWithConfigure = &CephRGWZonegroupResource{}
+var _ resource.ResourceWithImportState = &CephRGWZonegroupResource{}
+
+type CephRGWZonegroupResource struct {
+	client *client.Client
+}
+
+type CephRGWZonegroupResourceModel struct {
+	Name             types.String                  `tfsdk:"name"`
+	Realm            types.String                  `tfsdk:"realm"`
+	Master           types.Bool                    `tfsdk:"master"`
+	Default          types.Bool                    `tfsdk:"default"`
+	Endpoints        types.List                    `tfsdk:"endpoints"`
+	PlacementTargets []CephRGWPlacementTargetModel `tfsdk:"placement_targets"`
+	DefaultPlacement types.String                  `tfsdk:"default_placement"`
+	ID               types.String                  `tfsdk:"id"`
+}
+
+type CephRGWPlacementTargetModel struct {
+	Name types.String `tfsdk:"name"`
+	Tags types.List   `tfsdk:"tags"`
+}
+
+func NewCephRGWZonegroupResource() resource.Resource {
+	return &CephRGWZonegroupResource{}
+}
+
+func (r *CephRGWZonegroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rgw_zonegroup"
+}
+
+func (r *CephRGWZonegroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an RGW multisite zonegroup. The period of the realm is committed after every change.",
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the zonegroup",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"realm": schema.StringAttribute{
+				MarkdownDescription: "The name of the realm the zonegroup belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"master": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the master zonegroup of the realm. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"default": schema.BoolAttribute{
+				MarkdownDescription: "Whether this is the default zonegroup. Default: false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"endpoints": schema.ListAttribute{
+				MarkdownDescription: "The endpoints of the zonegroup (e.g., http://rgw1.example.com:8080)",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"placement_targets": schema.ListNestedAttribute{
+				MarkdownDescription: "The placement targets of the zonegroup. Defaults to the default-placement target.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the placement target (e.g., default-placement)",
+							Required:            true,
+						},
+						"tags": schema.ListAttribute{
+							MarkdownDescription: "The tags a user needs to place buckets on the target",
+							ElementType:         types.StringType,
+							Optional:            true,
+						},
+					},
+				},
+			},
+			"default_placement": schema.StringAttribute{
+				MarkdownDescription: "The default placement target of the zonegroup",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the zonegroup",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CephRGWZonegroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// expandRGWEndpoints converts an endpoints list into the comma separated form of the API
+func expandRGWEndpoints(ctx context.Context, endpoints types.List) (string, diag.Diagnostics) {
+	var result []string
+	var diags diag.Diagnostics
+	if !endpoints.IsNull() && !endpoints.IsUnknown() {
+		diags.Append(endpoints.ElementsAs(ctx, &result, false)...)
+	}
+	return client.JoinRGWEndpoints(result), diags
+}
+
+// flattenRGWEndpoints converts endpoints from the API into a list, where no endpoints is null
+func flattenRGWEndpoints(ctx context.Context, endpoints []string) (types.List, diag.Diagnostics) {
+	if len(endpoints) == 0 {
+		return types.ListNull(types.StringType), nil
+	}
+	return types.ListValueFrom(ctx, types.StringType, endpoints)
+}
+
+func (r *CephRGWZonegroupResource) expand(ctx context.Context, data *CephRGWZonegroupResourceModel) (client.RGWZonegroupRequest, diag.Diagnostics) {
+	zonegroup := client.RGWZonegroupRequest{
+		RealmName:     data.Realm.ValueString(),
+		ZonegroupName: data.Name.ValueString(),
+		Default:       data.Default.ValueBool(),
+		Master:        data.Master.ValueBool(),
+	}
+
+	endpoints, diags := expandRGWEndpoints(ctx, data.Endpoints)
+	zonegroup.Endpoints = endpoints
+
+	for _, target := range data.PlacementTargets {
+		t := client.RGWPlacementTarget{Name: target.Name.ValueString(), Tags: []string{}}
+		if !target.Tags.IsNull() && !target.Tags.IsUnknown() {
+			diags.Append(target.Tags.ElementsAs(ctx, &t.Tags, false)...)
+		}
+		zonegroup.PlacementTargets = append(zonegroup.PlacementTargets, t)
+	}
+
+	return zonegroup, diags
+}
+
+func (r *CephRGWZonegroupResource) refresh(ctx context.Context, data *CephRGWZonegroupResourceModel) error {
+	zonegroup, err := r.client.GetRGWZonegroup(data.Name.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(zonegroup.ID)
+	data.Master = types.BoolValue(bool(zonegroup.IsMaster))
+	data.DefaultPlacement = types.StringValue(zonegroup.DefaultPlacement)
+	// The API does not report whether the zonegroup is the default one
+	if data.Default.IsNull() {
+		data.Default = types.BoolValue(false)
+	}
+
+	endpoints, diags := flattenRGWEndpoints(ctx, zonegroup.Endpoints)
+	if diags.HasError() {
+		return fmt.Errorf("unable to set endpoints: %v", diags)
+	}
+	data.Endpoints = endpoints
+
+	targets := make([]CephRGWPlacementTargetModel, 0, len(zonegroup.PlacementTargets))
+	for _, target := range zonegroup.PlacementTargets {
+		t := CephRGWPlacementTargetModel{Name: types.StringValue(target.Name), Tags: types.ListNull(types.StringType)}
+		if len(target.Tags) > 0 {
+			t.Tags, diags = types.ListValueFrom(ctx, types.StringType, target.Tags)
+			if diags.HasError() {
+				return fmt.Errorf("unable to set placement target tags: %v", diags)
+			}
+		}
+		targets = append(targets, t)
+	}
+	data.PlacementTargets = targets
+
+	return nil
+}
+
+// commitRGWPeriod commits the period of the realm so that changes become effective
+func commitRGWPeriod(c *client.Client, realm string) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if err := c.CommitRGWPeriod(realm); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to commit the period of RGW realm %s: %s", realm, err))
+	}
+	return diags
+}
+
+func (r *CephRGWZonegroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CephRGWZonegroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zonegroup, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.CreateRGWZonegroup(zonegroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RGW zonegroup: %s", err))
+		return
+	}
+
+	// Placement targets can only be set on an existing zonegroup
+	if len(zonegroup.PlacementTargets) > 0 {
+		err = r.client.UpdateRGWZonegroup(zonegroup)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set placement targets of RGW zonegroup: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(commitRGWPeriod(r.client, zonegroup.RealmName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created RGW zonegroup: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWZonegroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CephRGWZonegroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.refresh(ctx, &data)
+	if errors.Is(err, client.ErrNotFound) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RGW zonegroup: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWZonegroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CephRGWZonegroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	zonegroup, diags := r.expand(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.UpdateRGWZonegroup(zonegroup)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RGW zonegroup: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(commitRGWPeriod(r.client, zonegroup.RealmName)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = r.refresh(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated RGW zonegroup: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CephRGWZonegroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CephRGWZonegroupResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRGWZonegroup(data.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RGW zonegroup: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(commitRGWPeriod(r.client, data.Realm.ValueString())...)
+}
+
+func (r *CephRGWZonegroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	realm, name, ok := strings.Cut(req.ID, ":")
+	if !ok || realm == "" || name == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected <realm>:<zonegroup>, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("realm"), realm)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
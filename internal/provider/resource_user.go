@@ -34,6 +34,7 @@ func (r *CephUserResource) Metadata(ctx context.Context, req resource.MetadataRe
 
 func (r *CephUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version:             1,
 		MarkdownDescription: "Manages a Ceph user with RBD access to specified pools",
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
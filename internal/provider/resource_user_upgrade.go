@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ resource.ResourceWithUpgradeState = &CephUserResource{}
+
+// CephUserResourceModelV0 describes the ceph_user state written before
+// schema versioning was introduced.
+type CephUserResourceModelV0 struct {
+	Name  types.String `tfsdk:"name"`
+	Pools types.List   `tfsdk:"pools"`
+	Key   types.String `tfsdk:"key"`
+}
+
+// userSchemaV0 is a frozen copy of the version 0 ceph_user schema. It must
+// not be changed, as it is used to decode existing state files.
+func userSchemaV0() *schema.Schema {
+	return &schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+			"pools": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"key": schema.StringAttribute{Computed: true, Sensitive: true},
+		},
+	}
+}
+
+func (r *CephUserResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			PriorSchema: userSchemaV0(),
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				var prior CephUserResourceModelV0
+				resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				upgraded := CephUserResourceModel{
+					Name:  prior.Name,
+					Pools: prior.Pools,
+					Key:   prior.Key,
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, upgraded)...)
+			},
+		},
+	}
+}
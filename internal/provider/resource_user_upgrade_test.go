@@ -0,0 +1,25 @@
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCephUserResourceUpgradeStateV0(t *testing.T) {
+	state := testUpgradeState(t, &CephUserResource{}, 0, "user_v0.json")
+
+	var data CephUserResourceModel
+	if diags := state.Get(context.Background(), &data); diags.HasError() {
+		t.Fatalf("unable to read upgraded state: %v", diags)
+	}
+
+	if got := data.Name.ValueString(); got != "client.kubernetes-csi" {
+		t.Errorf("name = %q, want %q", got, "client.kubernetes-csi")
+	}
+	if got := len(data.Pools.Elements()); got != 2 {
+		t.Errorf("pools has %d elements, want 2", got)
+	}
+	if data.Key.ValueString() == "" {
+		t.Errorf("key was not carried over")
+	}
+}
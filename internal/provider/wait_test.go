@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWaitFor(t *testing.T) {
+	prior := pollInterval
+	pollInterval = time.Millisecond
+	t.Cleanup(func() { pollInterval = prior })
+
+	calls := 0
+	err := waitFor(context.Background(), time.Second, func() (bool, error) {
+		calls++
+		return calls == 3, nil
+	})
+	if err != nil || calls != 3 {
+		t.Errorf("got calls=%d err=%v, want 3 calls and no error", calls, err)
+	}
+
+	errCheck := errors.New("check failed")
+	err = waitFor(context.Background(), time.Second, func() (bool, error) {
+		return false, errCheck
+	})
+	if !errors.Is(err, errCheck) {
+		t.Errorf("got %v, want %v", err, errCheck)
+	}
+
+	err = waitFor(context.Background(), 10*time.Millisecond, func() (bool, error) {
+		return false, nil
+	})
+	if err == nil {
+		t.Error("expected a timeout error")
+	}
+}